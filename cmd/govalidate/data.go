@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const maxSafeInteger = 1<<53 - 1
+
+type document struct {
+	source string
+	data   any
+}
+
+func loadDocuments(path string) ([]document, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return decodeNDJSON(path, content)
+
+	case ".yaml", ".yml":
+		return decodeYAML(path, content)
+
+	default:
+		var data any
+		if err := json.Unmarshal(content, &data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		return []document{{source: path, data: normalizeNumbers(data)}}, nil
+	}
+}
+
+func decodeNDJSON(path string, content []byte) ([]document, error) {
+	var documents []document
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var data any
+		if err := json.Unmarshal(scanner.Bytes(), &data); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+
+		documents = append(documents, document{
+			source: fmt.Sprintf("%s:%d", path, line),
+			data:   normalizeNumbers(data),
+		})
+	}
+
+	return documents, scanner.Err()
+}
+
+func decodeYAML(path string, content []byte) ([]document, error) {
+	var documents []document
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+
+	for idx := 1; ; idx++ {
+		var node yaml.Node
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		data, err := fromYAMLNode(&node)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		documents = append(documents, document{
+			source: fmt.Sprintf("%s#%d", path, idx),
+			data:   normalizeNumbers(data),
+		})
+	}
+
+	if len(documents) == 1 {
+		documents[0].source = path
+	}
+
+	return documents, nil
+}
+
+func fromYAMLNode(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return fromYAMLNode(node.Content[0])
+
+	case yaml.AliasNode:
+		return fromYAMLNode(node.Alias)
+
+	case yaml.MappingNode:
+		data := make(map[string]any, len(node.Content)/2)
+
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			value, err := fromYAMLNode(node.Content[idx+1])
+			if err != nil {
+				return nil, err
+			}
+
+			data[node.Content[idx].Value] = value
+		}
+
+		return data, nil
+
+	case yaml.SequenceNode:
+		data := make([]any, len(node.Content))
+
+		for idx, item := range node.Content {
+			value, err := fromYAMLNode(item)
+			if err != nil {
+				return nil, err
+			}
+
+			data[idx] = value
+		}
+
+		return data, nil
+
+	default:
+		switch node.ShortTag() {
+		case "!!str", "!!timestamp", "!!binary":
+			return node.Value, nil
+		}
+
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	}
+}
+
+func normalizeNumbers(data any) any {
+	switch data := data.(type) {
+	case map[string]any:
+		for key, value := range data {
+			data[key] = normalizeNumbers(value)
+		}
+
+	case []any:
+		for idx, value := range data {
+			data[idx] = normalizeNumbers(value)
+		}
+
+	case float64:
+		if data == math.Trunc(data) && math.Abs(data) <= maxSafeInteger {
+			return int(data)
+		}
+	}
+
+	return data
+}
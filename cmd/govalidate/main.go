@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitError   = 2
+)
+
+type result struct {
+	Source string       `json:"source"`
+	Valid  bool         `json:"valid"`
+	Errors ve.ErrorsBag `json:"errors,omitempty"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("govalidate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage of govalidate:\n")
+		fmt.Fprintf(stderr, "\tgovalidate -rules rules.json [flags] file...\n")
+		fmt.Fprintf(stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+
+	rulesPath := flags.String("rules", "", "path to JSON or YAML rules file; must be set")
+	format := flags.String("format", "text", "output format: text or json")
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if *rulesPath == "" || flags.NArg() == 0 || (*format != "text" && *format != "json") {
+		flags.Usage()
+
+		return exitError
+	}
+
+	rules, err := loadRules(*rulesPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "govalidate: %s\n", err)
+
+		return exitError
+	}
+
+	var results []result
+
+	for _, path := range flags.Args() {
+		documents, err := loadDocuments(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "govalidate: %s\n", err)
+
+			return exitError
+		}
+
+		for _, document := range documents {
+			errorsBag, err := validate(context.Background(), document.data, rules)
+			if err != nil {
+				fmt.Fprintf(stderr, "govalidate: %s: %s\n", document.source, err)
+
+				return exitError
+			}
+
+			results = append(results, result{
+				Source: document.source,
+				Valid:  !errorsBag.Any(),
+				Errors: errorsBag,
+			})
+		}
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(results); err != nil {
+			fmt.Fprintf(stderr, "govalidate: %s\n", err)
+
+			return exitError
+		}
+	} else {
+		printText(stdout, results)
+	}
+
+	for _, result := range results {
+		if !result.Valid {
+			return exitInvalid
+		}
+	}
+
+	return exitValid
+}
+
+func validate(ctx context.Context, data any, rules validator.RulesMap) (ve.ErrorsBag, error) {
+	switch data := data.(type) {
+	case map[string]any:
+		return validator.ForMapWithContext(ctx, data, rules)
+
+	case []any:
+		return validator.ForSliceWithContext(ctx, data, []vr.Rule{
+			vr.WhenFunc(isMap, vr.Nested(rules)).Else(vr.Map()),
+		})
+
+	default:
+		return nil, fmt.Errorf("document must be an object or an array of objects")
+	}
+}
+
+func isMap(_ context.Context, value any, _ any) bool {
+	_, ok := value.(map[string]any)
+
+	return ok
+}
+
+func printText(w io.Writer, results []result) {
+	for _, result := range results {
+		if result.Valid {
+			fmt.Fprintf(w, "%s: OK\n", result.Source)
+
+			continue
+		}
+
+		fmt.Fprintf(w, "%s: %d field(s) failed:\n", result.Source, len(result.Errors))
+
+		fields := make([]string, 0, len(result.Errors))
+		for field := range result.Errors {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			for _, validationError := range result.Errors[field] {
+				fmt.Fprintf(w, "  %s: %s\n", field, validationError)
+			}
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const rulesJSON = `{
+	"name": ["required", "string", "min:3"],
+	"email": ["sometimes", "required", "email"],
+	"tags.*": ["in:foo,bar,1"]
+}`
+
+func Test_run(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		name           string
+		files          map[string]string
+		args           []string
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name: "valid JSON file",
+			files: map[string]string{
+				"data.json": `{"name": "John", "email": "john@example.com", "tags": ["foo", 1]}`,
+			},
+			args:           []string{"-rules", "rules.json", "data.json"},
+			expectedCode:   exitValid,
+			expectedStdout: "data.json: OK\n",
+		},
+		{
+			name: "invalid JSON file",
+			files: map[string]string{
+				"data.json": `{"name": "Jo", "email": "john", "tags": ["foo", "baz"]}`,
+			},
+			args:         []string{"-rules", "rules.json", "data.json"},
+			expectedCode: exitInvalid,
+			expectedStdout: "data.json: 3 field(s) failed:\n" +
+				"  email: must be a valid email address\n" +
+				"  name: must be at least 3 characters\n" +
+				"  tags.1: does not exist in [foo bar 1]\n",
+		},
+		{
+			name: "JSON array",
+			files: map[string]string{
+				"data.json": `[{"name": "John"}, {"email": "john@example.com"}, "foo"]`,
+			},
+			args:         []string{"-rules", "rules.json", "data.json"},
+			expectedCode: exitInvalid,
+			expectedStdout: "data.json: 2 field(s) failed:\n" +
+				"  1.name: is required\n" +
+				"  2: must be a map\n",
+		},
+		{
+			name: "NDJSON file",
+			files: map[string]string{
+				"data.ndjson": "{\"name\": \"John\"}\n\n{\"name\": null}\n",
+			},
+			args:         []string{"-rules", "rules.json", "data.ndjson"},
+			expectedCode: exitInvalid,
+			expectedStdout: "data.ndjson:1: OK\n" +
+				"data.ndjson:3: 1 field(s) failed:\n" +
+				"  name: is required\n",
+		},
+		{
+			name: "YAML files with YAML rules",
+			files: map[string]string{
+				"rules.yaml":  "name: [required, string]\nage: [integer, min:18]\n",
+				"single.yaml": "name: John\nage: 42\n",
+				"multi.yml":   "name: John\nage: 17\n---\nage: 18\n",
+			},
+			args:         []string{"-rules", "rules.yaml", "single.yaml", "multi.yml"},
+			expectedCode: exitInvalid,
+			expectedStdout: "single.yaml: OK\n" +
+				"multi.yml#1: 1 field(s) failed:\n" +
+				"  age: must be at least 18\n" +
+				"multi.yml#2: 1 field(s) failed:\n" +
+				"  name: is required\n",
+		},
+		{
+			name: "whole numbers are floats",
+			files: map[string]string{
+				"rules.yaml": "price: [required, float, min:5]\nquantity: [required, integer]\n",
+				"data.json":  `{"price": 10.0, "quantity": 2.0}`,
+				"data.yaml":  "price: 4\nquantity: 2.5\n",
+			},
+			args:         []string{"-rules", "rules.yaml", "data.json", "data.yaml"},
+			expectedCode: exitInvalid,
+			expectedStdout: "data.json: OK\n" +
+				"data.yaml: 2 field(s) failed:\n" +
+				"  price: must be at least 5\n" +
+				"  quantity: must be an int but is float64\n",
+		},
+		{
+			name: "YAML scalars",
+			files: map[string]string{
+				"rules.yaml": "born: [required, \"dateFormat:2006-01-02\"]\nitems.*.id: [required, integer]\ncode: [required, string]\n",
+				"data.yaml":  "born: 2024-01-31\nitem: &item {id: 1}\nitems: [*item, {id: 2}]\ncode: \"007\"\n",
+			},
+			args:           []string{"-rules", "rules.yaml", "data.yaml"},
+			expectedCode:   exitValid,
+			expectedStdout: "data.yaml: OK\n",
+		},
+		{
+			name: "JSON output",
+			files: map[string]string{
+				"data.ndjson": "{\"name\": \"John\"}\n{}\n",
+			},
+			args:         []string{"-rules", "rules.json", "-format", "json", "data.ndjson"},
+			expectedCode: exitInvalid,
+			expectedStdout: `[
+  {
+    "source": "data.ndjson:1",
+    "valid": true
+  },
+  {
+    "source": "data.ndjson:2",
+    "valid": false,
+    "errors": {
+      "name": [
+        {
+          "rule": "REQUIRED"
+        }
+      ]
+    }
+  }
+]
+`,
+		},
+		{
+			name:           "missing rules flag",
+			args:           []string{"data.json"},
+			expectedCode:   exitError,
+			expectedStderr: "Usage of govalidate:",
+		},
+		{
+			name:           "missing data files",
+			args:           []string{"-rules", "rules.json"},
+			expectedCode:   exitError,
+			expectedStderr: "Usage of govalidate:",
+		},
+		{
+			name:           "invalid format",
+			args:           []string{"-rules", "rules.json", "-format", "xml", "data.json"},
+			expectedCode:   exitError,
+			expectedStderr: "Usage of govalidate:",
+		},
+		{
+			name: "invalid rules",
+			files: map[string]string{
+				"invalid.json": `{"name": ["required", "foo:bar"]}`,
+			},
+			args:           []string{"-rules", "invalid.json", "data.json"},
+			expectedCode:   exitError,
+			expectedStderr: "govalidate: invalid.json: name: unknown rule \"foo\"\n",
+		},
+		{
+			name:           "missing data file",
+			args:           []string{"-rules", "rules.json", "data.json"},
+			expectedCode:   exitError,
+			expectedStderr: "govalidate: open data.json: no such file or directory\n",
+		},
+		{
+			name: "scalar document",
+			files: map[string]string{
+				"data.json": `"foo"`,
+			},
+			args:           []string{"-rules", "rules.json", "data.json"},
+			expectedCode:   exitError,
+			expectedStderr: "govalidate: data.json: document must be an object or an array of objects\n",
+		},
+	} {
+		ttIdx, tt := ttIdx, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			chdir(t, t.TempDir())
+
+			require.NoError(t, os.WriteFile("rules.json", []byte(rulesJSON), 0644), ttIdx)
+
+			for name, content := range tt.files {
+				require.NoError(t, os.WriteFile(name, []byte(content), 0644), ttIdx)
+			}
+
+			var stdout, stderr bytes.Buffer
+
+			// when
+			code := run(tt.args, &stdout, &stderr)
+
+			// then
+			require.Equal(t, tt.expectedCode, code, ttIdx)
+			require.Equal(t, tt.expectedStdout, stdout.String(), ttIdx)
+			require.Contains(t, stderr.String(), tt.expectedStderr, ttIdx)
+		})
+	}
+}
+
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(filepath.Clean(dir)))
+
+	t.Cleanup(func() {
+		require.NoError(t, os.Chdir(wd))
+	})
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type ruleFactory func(args []string) (vr.Rule, error)
+
+var ruleFactories = map[string]ruleFactory{
+	"after":            timeRule(vr.After),
+	"afterOrEqual":     timeRule(vr.AfterOrEqual),
+	"array":            simpleRule(func() vr.Rule { return vr.Array() }),
+	"bail":             simpleRule(func() vr.Rule { return vr.Bail() }),
+	"before":           timeRule(vr.Before),
+	"beforeOrEqual":    timeRule(vr.BeforeOrEqual),
+	"between":          numbersRule(2, betweenRule(vr.Between[int], vr.Between[float64])),
+	"betweenExclusive": numbersRule(2, betweenRule(vr.BetweenExclusive[int], vr.BetweenExclusive[float64])),
+	"boolean":          simpleRule(func() vr.Rule { return vr.Boolean() }),
+	"date":             simpleRule(func() vr.Rule { return vr.Date() }),
+	"dateFormat":       stringRule(func(format string) vr.Rule { return vr.DateFormat(format) }),
+	"doesntEndWith":    stringsRule(func(values []string) vr.Rule { return vr.DoesntEndWith(values[0], values[1:]...) }),
+	"doesntStartWith":  stringsRule(func(values []string) vr.Rule { return vr.DoesntStartWith(values[0], values[1:]...) }),
+	"duration":         simpleRule(func() vr.Rule { return vr.Duration() }),
+	"email":            simpleRule(func() vr.Rule { return vr.Email() }),
+	"emailAddress":     simpleRule(func() vr.Rule { return vr.EmailAddress() }),
+	"endsWith":         stringsRule(func(values []string) vr.Rule { return vr.EndsWith(values[0], values[1:]...) }),
+	"filled":           simpleRule(func() vr.Rule { return vr.Filled() }),
+	"float":            simpleRule(func() vr.Rule { return &jsonFloatRule{} }),
+	"in":               stringsRule(func(values []string) vr.Rule { return vr.In(values, vr.InRuleWithComparator(equals)) }),
+	"integer":          simpleRule(func() vr.Rule { return vr.Integer[int]() }),
+	"ip":               simpleRule(func() vr.Rule { return vr.IP() }),
+	"length":           lengthRule,
+	"map":              simpleRule(func() vr.Rule { return vr.Map() }),
+	"max":              numbersRule(1, thresholdRule(vr.Max[int], vr.Max[float64])),
+	"maxExclusive":     numbersRule(1, thresholdRule(vr.MaxExclusive[int], vr.MaxExclusive[float64])),
+	"min":              numbersRule(1, thresholdRule(vr.Min[int], vr.Min[float64])),
+	"minExclusive":     numbersRule(1, thresholdRule(vr.MinExclusive[int], vr.MinExclusive[float64])),
+	"missing":          simpleRule(func() vr.Rule { return vr.Missing() }),
+	"notIn":            stringsRule(func(values []string) vr.Rule { return vr.NotIn(values, vr.NotInRuleWithComparator(equals)) }),
+	"notRegex":         regexRule(func(regex *regexp.Regexp) vr.Rule { return vr.NotRegex(regex) }),
+	"nullable":         simpleRule(func() vr.Rule { return vr.Nullable() }),
+	"numeric":          simpleRule(func() vr.Rule { return vr.Numeric() }),
+	"present":          simpleRule(func() vr.Rule { return vr.Present() }),
+	"regex":            regexRule(func(regex *regexp.Regexp) vr.Rule { return vr.Regex(regex) }),
+	"required":         simpleRule(func() vr.Rule { return vr.Required() }),
+	"slice":            simpleRule(func() vr.Rule { return vr.Slice() }),
+	"sometimes":        simpleRule(func() vr.Rule { return vr.Sometimes() }),
+	"startsWith":       stringsRule(func(values []string) vr.Rule { return vr.StartsWith(values[0], values[1:]...) }),
+	"string":           simpleRule(func() vr.Rule { return vr.String() }),
+	"url":              simpleRule(func() vr.Rule { return vr.URL() }),
+	"uuid":             simpleRule(func() vr.Rule { return vr.UUID() }),
+}
+
+func loadRules(path string) (validator.RulesMap, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions map[string][]string
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &definitions)
+
+	default:
+		err = json.Unmarshal(content, &definitions)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	rules := make(validator.RulesMap, len(definitions))
+
+	for field, fieldDefinitions := range definitions {
+		fieldRules := make([]vr.Rule, len(fieldDefinitions))
+
+		for idx, definition := range fieldDefinitions {
+			if fieldRules[idx], err = parseRule(definition); err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", path, field, err)
+			}
+		}
+
+		rules[field] = fieldRules
+	}
+
+	return rules, nil
+}
+
+func parseRule(definition string) (vr.Rule, error) {
+	name, arg, hasArgs := strings.Cut(definition, ":")
+
+	factory, ok := ruleFactories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule %q", name)
+	}
+
+	var args []string
+	if hasArgs {
+		args = []string{arg}
+	}
+
+	rule, err := factory(args)
+	if err != nil {
+		return nil, fmt.Errorf("rule %q: %w", name, err)
+	}
+
+	return rule, nil
+}
+
+func simpleRule(constructor func() vr.Rule) ruleFactory {
+	return func(args []string) (vr.Rule, error) {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("does not accept arguments")
+		}
+
+		return constructor(), nil
+	}
+}
+
+func stringRule(constructor func(string) vr.Rule) ruleFactory {
+	return func(args []string) (vr.Rule, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expects an argument")
+		}
+
+		return constructor(args[0]), nil
+	}
+}
+
+func stringsRule(constructor func([]string) vr.Rule) ruleFactory {
+	return func(args []string) (vr.Rule, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expects at least one argument")
+		}
+
+		return constructor(strings.Split(args[0], ",")), nil
+	}
+}
+
+func regexRule(constructor func(*regexp.Regexp) vr.Rule) ruleFactory {
+	return func(args []string) (vr.Rule, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expects a pattern")
+		}
+
+		regex, err := regexp.Compile(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return constructor(regex), nil
+	}
+}
+
+func timeRule[R vr.Rule](constructor func(time.Time) R) ruleFactory {
+	return func(args []string) (vr.Rule, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expects a RFC 3339 time")
+		}
+
+		t, err := time.Parse(time.RFC3339, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return constructor(t), nil
+	}
+}
+
+func lengthRule(args []string) (vr.Rule, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("expects an argument")
+	}
+
+	length, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return vr.Length(length), nil
+}
+
+func numbersRule(count int, constructor func(ints []int, floats []float64, isFloat bool) vr.Rule) ruleFactory {
+	return func(args []string) (vr.Rule, error) {
+		var parts []string
+		if len(args) > 0 {
+			parts = strings.Split(args[0], ",")
+		}
+
+		if len(parts) != count {
+			return nil, fmt.Errorf("expects %d number(s)", count)
+		}
+
+		ints := make([]int, count)
+		floats := make([]float64, count)
+		isFloat := false
+
+		for idx, part := range parts {
+			number, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			floats[idx] = number
+
+			if ints[idx], err = strconv.Atoi(part); err != nil {
+				isFloat = true
+			}
+		}
+
+		return constructor(ints, floats, isFloat), nil
+	}
+}
+
+func thresholdRule[RI, RF vr.Rule](intConstructor func(int) RI, floatConstructor func(float64) RF) func([]int, []float64, bool) vr.Rule {
+	return func(ints []int, floats []float64, isFloat bool) vr.Rule {
+		if isFloat {
+			return floatConstructor(floats[0])
+		}
+
+		return intConstructor(ints[0])
+	}
+}
+
+func betweenRule[RI, RF vr.Rule](intConstructor func(int, int) RI, floatConstructor func(float64, float64) RF) func([]int, []float64, bool) vr.Rule {
+	return func(ints []int, floats []float64, isFloat bool) vr.Rule {
+		if isFloat {
+			return floatConstructor(floats[0], floats[1])
+		}
+
+		return intConstructor(ints[0], ints[1])
+	}
+}
+
+func equals(value, expectedValue any) bool {
+	expected := expectedValue.(string)
+
+	switch value := value.(type) {
+	case string:
+		return value == expected
+
+	case int:
+		number, err := strconv.Atoi(expected)
+
+		return err == nil && value == number
+
+	case float64:
+		number, err := strconv.ParseFloat(expected, 64)
+
+		return err == nil && value == number
+
+	case bool:
+		boolean, err := strconv.ParseBool(expected)
+
+		return err == nil && value == boolean
+
+	case nil:
+		return expected == "null"
+
+	default:
+		return false
+	}
+}
+
+type jsonFloatRule struct {
+	vr.Bailer
+}
+
+func (r *jsonFloatRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	if integer, ok := value.(int); ok {
+		value = float64(integer)
+	}
+
+	newValue, err := vr.Float[float64]().Apply(ctx, value, data)
+	if err != nil {
+		r.MarkBailed()
+	}
+
+	return newValue, err
+}
+
+func (*jsonFloatRule) Describe() vr.RuleDescriptor {
+	return vr.Float[float64]().Describe()
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_parseRule(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		definition   string
+		expectedRule vr.Rule
+	}{
+		{definition: "required", expectedRule: vr.Required()},
+		{definition: "integer", expectedRule: vr.Integer[int]()},
+		{definition: "float", expectedRule: &jsonFloatRule{}},
+		{definition: "min:3", expectedRule: vr.Min(3)},
+		{definition: "max:2.5", expectedRule: vr.Max(2.5)},
+		{definition: "minExclusive:-1", expectedRule: vr.MinExclusive(-1)},
+		{definition: "between:1,10", expectedRule: vr.Between(1, 10)},
+		{definition: "between:1,10.5", expectedRule: vr.Between(1.0, 10.5)},
+		{definition: "length:4", expectedRule: vr.Length(4)},
+		{definition: "dateFormat:2006-01-02", expectedRule: vr.DateFormat("2006-01-02")},
+		{definition: "startsWith:foo,bar", expectedRule: vr.StartsWith("foo", "bar")},
+		{definition: "regex:^a,b:c$", expectedRule: vr.Regex(regexp.MustCompile("^a,b:c$"))},
+		{definition: "after:2023-01-02T15:04:05Z", expectedRule: vr.After(time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC))},
+	} {
+		// when
+		rule, err := parseRule(tt.definition)
+
+		// then
+		require.NoError(t, err, ttIdx)
+		require.Equal(t, tt.expectedRule, rule, ttIdx)
+	}
+}
+
+func Test_parseRule_Fails(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		definition    string
+		expectedError string
+	}{
+		{definition: "foo", expectedError: `unknown rule "foo"`},
+		{definition: "required:1", expectedError: `rule "required": does not accept arguments`},
+		{definition: "min", expectedError: `rule "min": expects 1 number(s)`},
+		{definition: "between:1", expectedError: `rule "between": expects 2 number(s)`},
+		{definition: "max:foo", expectedError: `rule "max": strconv.ParseFloat: parsing "foo": invalid syntax`},
+		{definition: "length:1.5", expectedError: `rule "length": strconv.Atoi: parsing "1.5": invalid syntax`},
+		{definition: "regex:(", expectedError: "rule \"regex\": error parsing regexp: missing closing ): `(`"},
+		{definition: "in", expectedError: `rule "in": expects at least one argument`},
+		{definition: "before:tomorrow", expectedError: `rule "before": parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006"`},
+	} {
+		// when
+		rule, err := parseRule(tt.definition)
+
+		// then
+		require.Nil(t, rule, ttIdx)
+		require.EqualError(t, err, tt.expectedError, ttIdx)
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+)
+
+type generator struct {
+	buffer    bytes.Buffer
+	usesMail  bool
+	generated map[string]bool
+}
+
+func generate(pkg *packageSpec) ([]byte, error) {
+	g := &generator{
+		generated: map[string]bool{},
+	}
+
+	var body bytes.Buffer
+
+	for _, spec := range pkg.Structs {
+		fmt.Fprintf(&body, "func Validate%s(_ context.Context, data *%s) ve.ErrorsBag {\n", spec.Name, spec.Name)
+		fmt.Fprintf(&body, "errorsBag := ve.NewErrorsBag()\n\n")
+		fmt.Fprintf(&body, "validate%s(data, \"\", errorsBag)\n\n", spec.Name)
+		fmt.Fprintf(&body, "return errorsBag\n}\n\n")
+	}
+
+	for _, spec := range pkg.Structs {
+		g.generateStruct(spec)
+	}
+
+	body.Write(g.buffer.Bytes())
+
+	var source bytes.Buffer
+
+	fmt.Fprintf(&source, "// Code generated by validatorgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package %s\n\n", pkg.Name)
+	fmt.Fprintf(&source, "import (\n\"context\"\n")
+
+	if g.usesMail {
+		fmt.Fprintf(&source, "\"net/mail\"\n")
+	}
+
+	fmt.Fprintf(&source, "\nve \"github.com/donatorsky/go-validator/error\"\n")
+
+	if bytes.Contains(body.Bytes(), []byte("vr.")) {
+		fmt.Fprintf(&source, "vr \"github.com/donatorsky/go-validator/rule\"\n")
+	}
+
+	fmt.Fprintf(&source, ")\n\n")
+	source.Write(body.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return formatted, nil
+}
+
+func (g *generator) generateStruct(spec *structSpec) {
+	if g.generated[spec.Name] {
+		return
+	}
+
+	g.generated[spec.Name] = true
+
+	var (
+		calls     bytes.Buffer
+		functions bytes.Buffer
+		nested    []*structSpec
+	)
+
+	for _, field := range spec.Fields {
+		if statements := g.fieldStatements(field); statements != "" {
+			funcName := "validate" + spec.Name + field.GoName
+
+			fmt.Fprintf(&calls, "%s(data.%s, prefix+%q, errorsBag)\n", funcName, field.GoName, field.Path)
+			fmt.Fprintf(&functions, "func %s(value %s, field string, errorsBag ve.ErrorsBag) {\n%s}\n\n", funcName, field.Type.GoType, statements)
+		}
+
+		if field.Nested == nil {
+			continue
+		}
+
+		if field.Embedded {
+			fmt.Fprintf(&calls, "validate%s(&data.%s, prefix, errorsBag)\n", field.Nested.Name, field.GoName)
+		} else {
+			fmt.Fprintf(&calls, "validate%s(&data.%s, prefix+%q, errorsBag)\n", field.Nested.Name, field.GoName, field.Path+".")
+		}
+
+		nested = append(nested, field.Nested)
+	}
+
+	if calls.Len() == 0 {
+		fmt.Fprintf(&g.buffer, "func validate%s(_ *%s, _ string, _ ve.ErrorsBag) {\n}\n\n", spec.Name, spec.Name)
+	} else {
+		fmt.Fprintf(&g.buffer, "func validate%s(data *%s, prefix string, errorsBag ve.ErrorsBag) {\n%s}\n\n", spec.Name, spec.Name, calls.String())
+	}
+
+	g.buffer.Write(functions.Bytes())
+
+	for _, nestedSpec := range nested {
+		g.generateStruct(nestedSpec)
+	}
+}
+
+func (g *generator) fieldStatements(field *fieldSpec) string {
+	var (
+		statements strings.Builder
+		notNil     bool
+	)
+
+	for _, rule := range field.Rules {
+		statement := g.ruleStatement(rule, field.Type, notNil)
+		if statement == "" {
+			continue
+		}
+
+		if statements.Len() > 0 {
+			statements.WriteString("\n")
+		}
+
+		statements.WriteString(statement)
+
+		if rule.Name == "required" {
+			notNil = true
+		}
+	}
+
+	return statements.String()
+}
+
+func (g *generator) ruleStatement(rule ruleSpec, fieldType *typeSpec, notNil bool) string {
+	if rule.Name == "required" {
+		switch fieldType.Kind {
+		case kindPointer, kindSlice, kindMap:
+			return "if value == nil {\nerrorsBag.Add(field, vr.NewRequiredValidationError())\n\nreturn\n}\n"
+
+		default:
+			return ""
+		}
+	}
+
+	value, kind, guard := "value", fieldType.Kind, ""
+
+	switch fieldType.Kind {
+	case kindPointer:
+		value, kind, guard = "*value", fieldType.Elem.Kind, "value != nil"
+
+	case kindSlice, kindMap:
+		guard = "value != nil"
+	}
+
+	var statement string
+
+	switch rule.Name {
+	case "filled":
+		condition := ""
+
+		switch kind {
+		case kindString:
+			condition = value + ` == ""`
+
+		case kindNumber:
+			condition = value + " == 0"
+
+		case kindBool:
+			condition = "!" + value
+
+		case kindSlice, kindMap:
+			condition, guard = "value == nil", ""
+		}
+
+		statement = fmt.Sprintf("if %s {\nerrorsBag.Add(field, vr.NewFilledValidationError())\n}\n", condition)
+
+	case "email":
+		g.usesMail = true
+
+		statement = fmt.Sprintf("if _, err := mail.ParseAddress(%s); err != nil {\nerrorsBag.Add(field, vr.NewEmailValidationError())\n}\n", value)
+
+	default:
+		statement = g.thresholdStatement(rule, fieldType, value, kind)
+	}
+
+	if guard == "" || notNil {
+		return statement
+	}
+
+	return fmt.Sprintf("if %s {\n%s}\n", guard, statement)
+}
+
+func (g *generator) thresholdStatement(rule ruleSpec, fieldType *typeSpec, value, kind string) string {
+	var (
+		subject   = "len(" + value + ")"
+		valueType = "ve.TypeString"
+		castType  = ""
+		args      = numberLiterals(rule.Args)
+	)
+
+	switch kind {
+	case kindNumber:
+		subject = value
+		valueType = "ve.TypeNumber"
+
+		numberType := fieldType
+		if numberType.Kind == kindPointer {
+			numberType = numberType.Elem
+		}
+
+		if numberType.GoType != numberType.Number {
+			castType = numberType.Number
+		}
+
+		for _, arg := range args {
+			if numberType.Number == "uint64" && strings.HasPrefix(arg, "-") {
+				castType = "float64"
+			}
+		}
+
+	case kindSlice:
+		valueType = "ve.TypeSlice"
+
+	case kindMap:
+		valueType = "ve.TypeMap"
+	}
+
+	for _, arg := range args {
+		if isFloatLiteral(arg) {
+			castType = "float64"
+		}
+	}
+
+	if castType != "" {
+		subject = castType + "(" + subject + ")"
+	}
+
+	var condition, constructor string
+
+	switch rule.Name {
+	case "min":
+		condition = fmt.Sprintf("%s < %s", subject, args[0])
+		constructor = fmt.Sprintf("vr.NewMinValidationError(%s, %s, true)", valueType, args[0])
+
+	case "max":
+		condition = fmt.Sprintf("%s > %s", subject, args[0])
+		constructor = fmt.Sprintf("vr.NewMaxValidationError(%s, %s, true)", valueType, args[0])
+
+	case "between":
+		condition = fmt.Sprintf("%s < %s || %s > %s", subject, args[0], subject, args[1])
+		constructor = fmt.Sprintf("vr.NewBetweenValidationError(%s, %s, %s, true)", valueType, args[0], args[1])
+
+	case "length":
+		condition = fmt.Sprintf("%s != %s", subject, args[0])
+		constructor = fmt.Sprintf("vr.NewLengthValidationError(%s, %s)", valueType, args[0])
+	}
+
+	return fmt.Sprintf("if %s {\nerrorsBag.Add(field, %s)\n}\n", condition, constructor)
+}
+
+func numberLiterals(args []string) []string {
+	literals := make([]string, len(args))
+	anyFloat := false
+
+	for idx, arg := range args {
+		if _, err := strconv.Atoi(arg); err == nil {
+			literals[idx] = arg
+
+			continue
+		}
+
+		number, _ := strconv.ParseFloat(arg, 64)
+		literals[idx] = strconv.FormatFloat(number, 'g', -1, 64)
+		anyFloat = true
+	}
+
+	if anyFloat {
+		for idx, literal := range literals {
+			if !isFloatLiteral(literal) {
+				literals[idx] = literal + ".0"
+			}
+		}
+	}
+
+	return literals
+}
+
+func isFloatLiteral(literal string) bool {
+	return strings.ContainsAny(literal, ".eE")
+}
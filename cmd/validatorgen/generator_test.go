@@ -0,0 +1,97 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_run_GeneratedFixturesAreUpToDate(t *testing.T) {
+	// given
+	expected, err := os.ReadFile(filepath.Join("internal", "fixtures", "signuprequest_validation.go"))
+	require.NoError(t, err)
+
+	// when
+	source, err := run(filepath.Join("internal", "fixtures"), "signuprequest_validation.go", []string{"SignUpRequest"})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(source))
+}
+
+func Test_run_FailsForUnsupportedDeclarations(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		name          string
+		source        string
+		expectedError string
+	}{
+		{
+			name:          "type not found",
+			source:        "type Other struct{}",
+			expectedError: "Request: struct type not found",
+		},
+		{
+			name:          "unsupported rule",
+			source:        "type Request struct{ Name string `validationRules:\"uuid\"` }",
+			expectedError: `Request.Name: unsupported rule "uuid"`,
+		},
+		{
+			name:          "missing argument",
+			source:        "type Request struct{ Name string `validationRules:\"min\"` }",
+			expectedError: `Request.Name: rule "min" expects 1 argument(s), got 0`,
+		},
+		{
+			name:          "invalid argument",
+			source:        "type Request struct{ Name string `validationRules:\"length=1.5\"` }",
+			expectedError: `Request.Name: rule "length": invalid argument "1.5"`,
+		},
+		{
+			name:          "rule not applicable to type",
+			source:        "type Request struct{ Name bool `validationRules:\"email\"` }",
+			expectedError: `Request.Name: rule "email" cannot be applied to bool`,
+		},
+		{
+			name:          "unsupported type",
+			source:        "type Request struct{ Name interface{} `validationRules:\"required\"` }",
+			expectedError: "Request.Name: unsupported type interface{}",
+		},
+		{
+			name:          "type from other package",
+			source:        "type Request struct{ Name []time.Time `validationRules:\"required\"` }",
+			expectedError: "Request.Name: unsupported type time.Time: types from other packages are not supported",
+		},
+		{
+			name:          "unexported field",
+			source:        "type Request struct{ name string `validationRules:\"required\"` }",
+			expectedError: "Request.name: unexported fields cannot be validated",
+		},
+		{
+			name:          "validation groups",
+			source:        "type Request struct{ Name string `validationRules:\"required\" validationGroups:\"create\"` }",
+			expectedError: "Request.Name: validation groups are not supported",
+		},
+		{
+			name:          "recursive type",
+			source:        "type Request struct{ Name string `validationRules:\"required\"`; Parent Inner }\ntype Inner struct{ Request Request }",
+			expectedError: "Request.Parent: Inner.Request: Request: recursive types are not supported",
+		},
+	} {
+		ttIdx, tt := ttIdx, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "request.go"), []byte("package request\n\n"+tt.source+"\n"), 0644), ttIdx)
+
+			// when
+			source, err := run(dir, "request_validation.go", []string{"Request"})
+
+			// then
+			require.Nil(t, source, ttIdx)
+			require.EqualError(t, err, tt.expectedError, ttIdx)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package fixtures
+
+//go:generate go run ../.. -type=SignUpRequest
+
+type SignUpRequest struct {
+	Contact
+
+	Email    string            `validationRules:"required,email"`
+	Password string            `validation:"password" validationRules:"required,filled,min=8,max=64"`
+	Age      *int              `validationRules:"required,between=18:130"`
+	Score    float32           `validationRules:"min=0.5,max=10"`
+	Level    uint8             `validationRules:"min=-1,max=300"`
+	Tags     []string          `validationRules:"required,min=1,max=3"`
+	Labels   map[string]string `validationRules:"length=2"`
+	Nickname *string           `validationRules:"filled,length=4"`
+	Accepted bool              `validationRules:"filled"`
+	Address  Address           `validation:"address"`
+	Comment  string
+}
+
+type Contact struct {
+	Phone string `validationRules:"filled,between=7:15"`
+}
+
+type Address struct {
+	Street string `validationRules:"filled,max=32"`
+	City   string `validationRules:"required,filled"`
+}
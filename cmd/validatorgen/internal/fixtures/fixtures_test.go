@@ -0,0 +1,180 @@
+package fixtures
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+var fakerInstance = faker.New()
+
+var signUpRequestRules = validator.RulesMap{
+	"Phone": {
+		vr.Filled(),
+		vr.Between(7, 15),
+	},
+	"Email": {
+		vr.Required(),
+		vr.Email(),
+	},
+	"password": {
+		vr.Required(),
+		vr.Filled(),
+		vr.Min(8),
+		vr.Max(64),
+	},
+	"Age": {
+		vr.Required(),
+		vr.Between(18, 130),
+	},
+	"Score": {
+		vr.Min(0.5),
+		vr.Max(10),
+	},
+	"Level": {
+		vr.Min(-1),
+		vr.Max(300),
+	},
+	"Tags": {
+		vr.Required(),
+		vr.Min(1),
+		vr.Max(3),
+	},
+	"Labels": {
+		vr.Length(2),
+	},
+	"Nickname": {
+		vr.Filled(),
+		vr.Length(4),
+	},
+	"Accepted": {
+		vr.Filled(),
+	},
+	"address.Street": {
+		vr.Filled(),
+		vr.Max(32),
+	},
+	"address.City": {
+		vr.Required(),
+		vr.Filled(),
+	},
+}
+
+func Test_ValidateSignUpRequest_IsEquivalentToForStruct(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		name string
+		data *SignUpRequest
+	}{
+		{
+			name: "empty",
+			data: &SignUpRequest{},
+		},
+		{
+			name: "valid",
+			data: &SignUpRequest{
+				Contact: Contact{
+					Phone: "123456789",
+				},
+				Email:    "john@example.com",
+				Password: "secret-password",
+				Age:      ptr(42),
+				Score:    7.5,
+				Level:    3,
+				Tags:     []string{"foo"},
+				Labels:   map[string]string{"foo": "bar", "bar": "baz"},
+				Nickname: ptr("john"),
+				Accepted: true,
+				Address: Address{
+					Street: "Foo Street",
+					City:   "Bar City",
+				},
+			},
+		},
+		{
+			name: "invalid",
+			data: &SignUpRequest{
+				Contact: Contact{
+					Phone: "123",
+				},
+				Email:    "john",
+				Password: strings.Repeat("a", 65),
+				Age:      ptr(17),
+				Score:    10.5,
+				Tags:     []string{},
+				Labels:   map[string]string{},
+				Nickname: ptr(""),
+				Address: Address{
+					Street: strings.Repeat("a", 33),
+				},
+			},
+		},
+	} {
+		ttIdx, tt := ttIdx, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidatorsAreEquivalent(t, tt.data, ttIdx)
+		})
+	}
+}
+
+func Test_ValidateSignUpRequest_IsEquivalentToForStruct_WithRandomData(t *testing.T) {
+	for idx := 0; idx < 100; idx++ {
+		data := &SignUpRequest{
+			Contact: Contact{
+				Phone: fakerInstance.Numerify(strings.Repeat("#", fakerInstance.IntBetween(0, 20))),
+			},
+			Email:    fakerInstance.RandomStringElement([]string{fakerInstance.Internet().Email(), fakerInstance.Lorem().Word(), ""}),
+			Password: strings.Repeat("a", fakerInstance.IntBetween(0, 70)),
+			Score:    float32(fakerInstance.RandomFloat(2, -5, 15)),
+			Level:    uint8(fakerInstance.IntBetween(0, 255)),
+			Accepted: fakerInstance.Boolean().Bool(),
+			Address: Address{
+				Street: strings.Repeat("a", fakerInstance.IntBetween(0, 40)),
+				City:   fakerInstance.RandomStringElement([]string{fakerInstance.Address().City(), ""}),
+			},
+		}
+
+		if fakerInstance.Boolean().Bool() {
+			data.Age = ptr(fakerInstance.IntBetween(0, 150))
+		}
+
+		if fakerInstance.Boolean().Bool() {
+			data.Tags = make([]string, fakerInstance.IntBetween(0, 5))
+		}
+
+		if fakerInstance.Boolean().Bool() {
+			data.Labels = make(map[string]string)
+
+			for labelIdx := fakerInstance.IntBetween(0, 4); labelIdx > 0; labelIdx-- {
+				data.Labels[fakerInstance.Lorem().Word()] = fakerInstance.Lorem().Word()
+			}
+		}
+
+		if fakerInstance.Boolean().Bool() {
+			data.Nickname = ptr(strings.Repeat("a", fakerInstance.IntBetween(0, 6)))
+		}
+
+		assertValidatorsAreEquivalent(t, data, idx)
+	}
+}
+
+func assertValidatorsAreEquivalent(t *testing.T, data *SignUpRequest, msgAndArgs ...any) {
+	t.Helper()
+
+	ctx := context.TODO()
+
+	expectedErrorsBag, err := validator.ForStructWithContext(ctx, data, signUpRequestRules)
+	require.NoError(t, err, msgAndArgs...)
+
+	require.Equal(t, expectedErrorsBag, ValidateSignUpRequest(ctx, data), msgAndArgs...)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,164 @@
+// Code generated by validatorgen; DO NOT EDIT.
+
+package fixtures
+
+import (
+	"context"
+	"net/mail"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func ValidateSignUpRequest(_ context.Context, data *SignUpRequest) ve.ErrorsBag {
+	errorsBag := ve.NewErrorsBag()
+
+	validateSignUpRequest(data, "", errorsBag)
+
+	return errorsBag
+}
+
+func validateSignUpRequest(data *SignUpRequest, prefix string, errorsBag ve.ErrorsBag) {
+	validateContact(&data.Contact, prefix, errorsBag)
+	validateSignUpRequestEmail(data.Email, prefix+"Email", errorsBag)
+	validateSignUpRequestPassword(data.Password, prefix+"password", errorsBag)
+	validateSignUpRequestAge(data.Age, prefix+"Age", errorsBag)
+	validateSignUpRequestScore(data.Score, prefix+"Score", errorsBag)
+	validateSignUpRequestLevel(data.Level, prefix+"Level", errorsBag)
+	validateSignUpRequestTags(data.Tags, prefix+"Tags", errorsBag)
+	validateSignUpRequestLabels(data.Labels, prefix+"Labels", errorsBag)
+	validateSignUpRequestNickname(data.Nickname, prefix+"Nickname", errorsBag)
+	validateSignUpRequestAccepted(data.Accepted, prefix+"Accepted", errorsBag)
+	validateAddress(&data.Address, prefix+"address.", errorsBag)
+}
+
+func validateSignUpRequestEmail(value string, field string, errorsBag ve.ErrorsBag) {
+	if _, err := mail.ParseAddress(value); err != nil {
+		errorsBag.Add(field, vr.NewEmailValidationError())
+	}
+}
+
+func validateSignUpRequestPassword(value string, field string, errorsBag ve.ErrorsBag) {
+	if value == "" {
+		errorsBag.Add(field, vr.NewFilledValidationError())
+	}
+
+	if len(value) < 8 {
+		errorsBag.Add(field, vr.NewMinValidationError(ve.TypeString, 8, true))
+	}
+
+	if len(value) > 64 {
+		errorsBag.Add(field, vr.NewMaxValidationError(ve.TypeString, 64, true))
+	}
+}
+
+func validateSignUpRequestAge(value *int, field string, errorsBag ve.ErrorsBag) {
+	if value == nil {
+		errorsBag.Add(field, vr.NewRequiredValidationError())
+
+		return
+	}
+
+	if int64(*value) < 18 || int64(*value) > 130 {
+		errorsBag.Add(field, vr.NewBetweenValidationError(ve.TypeNumber, 18, 130, true))
+	}
+}
+
+func validateSignUpRequestScore(value float32, field string, errorsBag ve.ErrorsBag) {
+	if float64(value) < 0.5 {
+		errorsBag.Add(field, vr.NewMinValidationError(ve.TypeNumber, 0.5, true))
+	}
+
+	if float64(value) > 10 {
+		errorsBag.Add(field, vr.NewMaxValidationError(ve.TypeNumber, 10, true))
+	}
+}
+
+func validateSignUpRequestLevel(value uint8, field string, errorsBag ve.ErrorsBag) {
+	if float64(value) < -1 {
+		errorsBag.Add(field, vr.NewMinValidationError(ve.TypeNumber, -1, true))
+	}
+
+	if uint64(value) > 300 {
+		errorsBag.Add(field, vr.NewMaxValidationError(ve.TypeNumber, 300, true))
+	}
+}
+
+func validateSignUpRequestTags(value []string, field string, errorsBag ve.ErrorsBag) {
+	if value == nil {
+		errorsBag.Add(field, vr.NewRequiredValidationError())
+
+		return
+	}
+
+	if len(value) < 1 {
+		errorsBag.Add(field, vr.NewMinValidationError(ve.TypeSlice, 1, true))
+	}
+
+	if len(value) > 3 {
+		errorsBag.Add(field, vr.NewMaxValidationError(ve.TypeSlice, 3, true))
+	}
+}
+
+func validateSignUpRequestLabels(value map[string]string, field string, errorsBag ve.ErrorsBag) {
+	if value != nil {
+		if len(value) != 2 {
+			errorsBag.Add(field, vr.NewLengthValidationError(ve.TypeMap, 2))
+		}
+	}
+}
+
+func validateSignUpRequestNickname(value *string, field string, errorsBag ve.ErrorsBag) {
+	if value != nil {
+		if *value == "" {
+			errorsBag.Add(field, vr.NewFilledValidationError())
+		}
+	}
+
+	if value != nil {
+		if len(*value) != 4 {
+			errorsBag.Add(field, vr.NewLengthValidationError(ve.TypeString, 4))
+		}
+	}
+}
+
+func validateSignUpRequestAccepted(value bool, field string, errorsBag ve.ErrorsBag) {
+	if !value {
+		errorsBag.Add(field, vr.NewFilledValidationError())
+	}
+}
+
+func validateContact(data *Contact, prefix string, errorsBag ve.ErrorsBag) {
+	validateContactPhone(data.Phone, prefix+"Phone", errorsBag)
+}
+
+func validateContactPhone(value string, field string, errorsBag ve.ErrorsBag) {
+	if value == "" {
+		errorsBag.Add(field, vr.NewFilledValidationError())
+	}
+
+	if len(value) < 7 || len(value) > 15 {
+		errorsBag.Add(field, vr.NewBetweenValidationError(ve.TypeString, 7, 15, true))
+	}
+}
+
+func validateAddress(data *Address, prefix string, errorsBag ve.ErrorsBag) {
+	validateAddressStreet(data.Street, prefix+"Street", errorsBag)
+	validateAddressCity(data.City, prefix+"City", errorsBag)
+}
+
+func validateAddressStreet(value string, field string, errorsBag ve.ErrorsBag) {
+	if value == "" {
+		errorsBag.Add(field, vr.NewFilledValidationError())
+	}
+
+	if len(value) > 32 {
+		errorsBag.Add(field, vr.NewMaxValidationError(ve.TypeString, 32, true))
+	}
+}
+
+func validateAddressCity(value string, field string, errorsBag ve.ErrorsBag) {
+	if value == "" {
+		errorsBag.Add(field, vr.NewFilledValidationError())
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma-separated list of struct type names; must be set")
+	output    = flag.String("output", "", "output file name; default <dir>/<type>_validation.go")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of validatorgen:\n")
+	fmt.Fprintf(os.Stderr, "\tvalidatorgen [flags] -type T [directory]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("validatorgen: ")
+
+	flag.Usage = usage
+	flag.Parse()
+
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if args := flag.Args(); len(args) == 1 {
+		dir = args[0]
+	} else if len(args) > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	types := strings.Split(*typeNames, ",")
+
+	outputName := *output
+	if outputName == "" {
+		outputName = filepath.Join(dir, strings.ToLower(types[0])+"_validation.go")
+	}
+
+	source, err := run(dir, filepath.Base(outputName), types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(outputName, source, 0644); err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+}
+
+func run(dir string, outputName string, types []string) ([]byte, error) {
+	pkg, structTypes, err := parsePackage(dir, outputName)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := newPackageParser(structTypes)
+
+	for _, typeName := range types {
+		spec, err := parser.parseStruct(strings.TrimSpace(typeName))
+		if err != nil {
+			return nil, err
+		}
+
+		pkg.Structs = append(pkg.Structs, spec)
+	}
+
+	return generate(pkg)
+}
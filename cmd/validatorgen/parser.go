@@ -0,0 +1,414 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	kindString  = "string"
+	kindNumber  = "number"
+	kindBool    = "bool"
+	kindSlice   = "slice"
+	kindMap     = "map"
+	kindPointer = "pointer"
+	kindStruct  = "struct"
+)
+
+type packageSpec struct {
+	Name    string
+	Structs []*structSpec
+}
+
+type structSpec struct {
+	Name   string
+	Fields []*fieldSpec
+}
+
+type fieldSpec struct {
+	GoName string
+	Path   string
+	Type   *typeSpec
+	Rules  []ruleSpec
+
+	Embedded bool
+	Nested   *structSpec
+}
+
+type typeSpec struct {
+	Kind   string
+	GoType string
+	Number string
+	Elem   *typeSpec
+}
+
+type ruleSpec struct {
+	Name string
+	Args []string
+}
+
+type packageParser struct {
+	types   map[string]*ast.StructType
+	structs map[string]*structSpec
+}
+
+func parsePackage(dir string, skipFile string) (*packageSpec, map[string]*ast.StructType, error) {
+	fileSet := token.NewFileSet()
+
+	packages, err := parser.ParseDir(fileSet, dir, nil, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		packageName string
+		structTypes = map[string]*ast.StructType{}
+	)
+
+	for name, pkg := range packages {
+		if strings.HasSuffix(name, "_test") {
+			continue
+		}
+
+		if packageName != "" {
+			return nil, nil, fmt.Errorf("multiple packages found in %s", dir)
+		}
+
+		packageName = name
+
+		for fileName, file := range pkg.Files {
+			if strings.HasSuffix(fileName, "_test.go") || filepath.Base(fileName) == skipFile {
+				continue
+			}
+
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok && typeSpec.TypeParams == nil {
+						structTypes[typeSpec.Name.Name] = structType
+					}
+				}
+			}
+		}
+	}
+
+	if packageName == "" {
+		return nil, nil, fmt.Errorf("no package found in %s", dir)
+	}
+
+	return &packageSpec{Name: packageName}, structTypes, nil
+}
+
+func newPackageParser(types map[string]*ast.StructType) *packageParser {
+	return &packageParser{
+		types:   types,
+		structs: map[string]*structSpec{},
+	}
+}
+
+func (p *packageParser) parseStruct(name string) (*structSpec, error) {
+	if spec, ok := p.structs[name]; ok {
+		if spec.Fields == nil {
+			return nil, fmt.Errorf("%s: recursive types are not supported", name)
+		}
+
+		return spec, nil
+	}
+
+	structType, ok := p.types[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: struct type not found", name)
+	}
+
+	spec := &structSpec{Name: name}
+	p.structs[name] = spec
+
+	fields := make([]*fieldSpec, 0, len(structType.Fields.List))
+
+	for _, field := range structType.Fields.List {
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			tagValue, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+
+			tag = reflect.StructTag(tagValue)
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			names = []*ast.Ident{ident}
+		}
+
+		for _, fieldName := range names {
+			fieldSpec, err := p.parseField(fieldName.Name, field, tag)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", name, fieldName.Name, err)
+			}
+
+			if fieldSpec != nil {
+				fields = append(fields, fieldSpec)
+			}
+		}
+	}
+
+	spec.Fields = fields
+
+	return spec, nil
+}
+
+func (p *packageParser) parseField(name string, field *ast.Field, tag reflect.StructTag) (*fieldSpec, error) {
+	rules := parseRulesTag(tag.Get("validationRules"))
+
+	if _, isStruct := p.structType(field.Type); !isStruct && len(rules) == 0 {
+		return nil, nil
+	}
+
+	if !ast.IsExported(name) && len(field.Names) > 0 {
+		return nil, fmt.Errorf("unexported fields cannot be validated")
+	}
+
+	if len(field.Names) == 0 && len(rules) > 0 {
+		return nil, fmt.Errorf("rules of embedded fields are not supported")
+	}
+
+	if tag.Get("validationGroups") != "" {
+		return nil, fmt.Errorf("validation groups are not supported")
+	}
+
+	fieldType, err := p.parseType(field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &fieldSpec{
+		GoName:   name,
+		Path:     name,
+		Type:     fieldType,
+		Rules:    rules,
+		Embedded: len(field.Names) == 0,
+	}
+
+	if path := tag.Get("validation"); path != "" {
+		spec.Path = path
+	}
+
+	if structName, isStruct := p.structType(field.Type); isStruct {
+		if spec.Nested, err = p.parseStruct(structName); err != nil {
+			return nil, err
+		}
+
+		if len(spec.Nested.Fields) == 0 && len(rules) == 0 {
+			return nil, nil
+		}
+	}
+
+	for _, rule := range rules {
+		if err := checkRule(rule, fieldType); err != nil {
+			return nil, err
+		}
+	}
+
+	return spec, nil
+}
+
+func (p *packageParser) structType(expr ast.Expr) (string, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+
+	_, ok = p.types[ident.Name]
+
+	return ident.Name, ok
+}
+
+func (p *packageParser) parseType(expr ast.Expr) (*typeSpec, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		switch expr.Name {
+		case "string":
+			return &typeSpec{Kind: kindString, GoType: expr.Name}, nil
+
+		case "bool":
+			return &typeSpec{Kind: kindBool, GoType: expr.Name}, nil
+
+		case "int", "int8", "int16", "int32", "int64":
+			return &typeSpec{Kind: kindNumber, GoType: expr.Name, Number: "int64"}, nil
+
+		case "uint", "uint8", "uint16", "uint32", "uint64", "byte":
+			return &typeSpec{Kind: kindNumber, GoType: expr.Name, Number: "uint64"}, nil
+
+		case "float32", "float64":
+			return &typeSpec{Kind: kindNumber, GoType: expr.Name, Number: "float64"}, nil
+		}
+
+		if _, ok := p.types[expr.Name]; ok {
+			return &typeSpec{Kind: kindStruct, GoType: expr.Name}, nil
+		}
+
+	case *ast.ArrayType:
+		if expr.Len != nil {
+			break
+		}
+
+		elem, err := p.typeString(expr.Elt)
+		if err != nil {
+			return nil, err
+		}
+
+		return &typeSpec{Kind: kindSlice, GoType: "[]" + elem}, nil
+
+	case *ast.MapType:
+		key, err := p.typeString(expr.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := p.typeString(expr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &typeSpec{Kind: kindMap, GoType: "map[" + key + "]" + value}, nil
+
+	case *ast.StarExpr:
+		elem, err := p.parseType(expr.X)
+		if err != nil {
+			return nil, err
+		}
+
+		switch elem.Kind {
+		case kindString, kindNumber, kindBool:
+			return &typeSpec{Kind: kindPointer, GoType: "*" + elem.GoType, Elem: elem}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", types.ExprString(expr))
+}
+
+func (p *packageParser) typeString(expr ast.Expr) (string, error) {
+	var err error
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if _, ok := node.(*ast.SelectorExpr); ok {
+			err = fmt.Errorf("unsupported type %s: types from other packages are not supported", types.ExprString(expr))
+		}
+
+		return err == nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return types.ExprString(expr), nil
+}
+
+func parseRulesTag(tag string) []ruleSpec {
+	if tag == "" {
+		return nil
+	}
+
+	parts := strings.Split(tag, ",")
+	rules := make([]ruleSpec, 0, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		rule := ruleSpec{Name: part}
+
+		if name, args, ok := strings.Cut(part, "="); ok {
+			rule.Name = name
+			rule.Args = strings.Split(args, ":")
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func checkRule(rule ruleSpec, fieldType *typeSpec) error {
+	kind := fieldType.Kind
+	if kind == kindPointer {
+		kind = fieldType.Elem.Kind
+	}
+
+	var (
+		args        int
+		integerArgs bool
+		kinds       []string
+	)
+
+	switch rule.Name {
+	case "required":
+		kinds = []string{kindString, kindNumber, kindBool, kindSlice, kindMap, kindStruct}
+
+	case "filled":
+		kinds = []string{kindString, kindNumber, kindBool, kindSlice, kindMap}
+
+	case "min", "max":
+		args = 1
+		kinds = []string{kindString, kindNumber, kindSlice, kindMap}
+
+	case "between":
+		args = 2
+		kinds = []string{kindString, kindNumber, kindSlice, kindMap}
+
+	case "length":
+		args = 1
+		integerArgs = true
+		kinds = []string{kindString, kindSlice, kindMap}
+
+	case "email":
+		kinds = []string{kindString}
+
+	default:
+		return fmt.Errorf("unsupported rule %q", rule.Name)
+	}
+
+	if len(rule.Args) != args {
+		return fmt.Errorf("rule %q expects %d argument(s), got %d", rule.Name, args, len(rule.Args))
+	}
+
+	for _, arg := range rule.Args {
+		if _, err := strconv.Atoi(arg); err == nil {
+			continue
+		}
+
+		if _, err := strconv.ParseFloat(arg, 64); err != nil || integerArgs {
+			return fmt.Errorf("rule %q: invalid argument %q", rule.Name, arg)
+		}
+	}
+
+	for _, k := range kinds {
+		if k == kind {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("rule %q cannot be applied to %s", rule.Name, fieldType.GoType)
+}
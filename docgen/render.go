@@ -0,0 +1,53 @@
+package docgen
+
+import (
+	"html/template"
+	"strings"
+
+	validator "github.com/donatorsky/go-validator"
+)
+
+var htmlTemplate = template.Must(template.New("rules").Parse(`<table>
+<thead>
+<tr><th>Field</th><th>Type</th><th>Constraints</th><th>Notes</th></tr>
+</thead>
+<tbody>
+{{- range . }}
+<tr><td><code>{{ .Field }}</code></td><td>{{ .Type }}</td><td>{{ range $idx, $item := .Constraints }}{{ if $idx }}<br>{{ end }}{{ $item }}{{ end }}</td><td>{{ range $idx, $item := .Notes }}{{ if $idx }}<br>{{ end }}{{ $item }}{{ end }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+`))
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func Markdown(rules validator.RulesMap) string {
+	var builder strings.Builder
+
+	builder.WriteString("| Field | Type | Constraints | Notes |\n")
+	builder.WriteString("|---|---|---|---|\n")
+
+	for _, row := range Rows(rules) {
+		builder.WriteString("| `")
+		builder.WriteString(markdownEscaper.Replace(row.Field))
+		builder.WriteString("` | ")
+		builder.WriteString(markdownEscaper.Replace(row.Type))
+		builder.WriteString(" | ")
+		builder.WriteString(markdownEscaper.Replace(strings.Join(row.Constraints, "<br>")))
+		builder.WriteString(" | ")
+		builder.WriteString(markdownEscaper.Replace(strings.Join(row.Notes, "<br>")))
+		builder.WriteString(" |\n")
+	}
+
+	return builder.String()
+}
+
+func HTML(rules validator.RulesMap) (template.HTML, error) {
+	var builder strings.Builder
+
+	if err := htmlTemplate.Execute(&builder, Rows(rules)); err != nil {
+		return "", err
+	}
+
+	return template.HTML(builder.String()), nil
+}
@@ -0,0 +1,53 @@
+package docgen
+
+import (
+	"html/template"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+var renderTestRules = validator.RulesMap{
+	"name": {
+		vr.Required(),
+		vr.String(),
+		vr.Regex(regexp.MustCompile(`^(a|<b>)$`)),
+	},
+	"role": {
+		vr.When(true, vr.Required()),
+		vr.Group([]string{"admin"}, vr.Filled()),
+	},
+}
+
+func Test_Markdown(t *testing.T) {
+	// when
+	markdown := Markdown(renderTestRules)
+
+	// then
+	require.Equal(t, "| Field | Type | Constraints | Notes |\n"+
+		"|---|---|---|---|\n"+
+		"| `name` | string | required<br>matches `^(a\\|<b>)$` |  |\n"+
+		"| `role` | any |  | when the condition is met: required<br>in groups admin: must not be empty |\n", markdown)
+}
+
+func Test_HTML(t *testing.T) {
+	// when
+	html, err := HTML(renderTestRules)
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, template.HTML(`<table>
+<thead>
+<tr><th>Field</th><th>Type</th><th>Constraints</th><th>Notes</th></tr>
+</thead>
+<tbody>
+<tr><td><code>name</code></td><td>string</td><td>required<br>matches `+"`^(a|&lt;b&gt;)$`"+`</td><td></td></tr>
+<tr><td><code>role</code></td><td>any</td><td></td><td>when the condition is met: required<br>in groups admin: must not be empty</td></tr>
+</tbody>
+</table>
+`), html)
+}
@@ -0,0 +1,399 @@
+package docgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+	vs "github.com/donatorsky/go-validator/sanitize"
+)
+
+const anyType = "any"
+
+type Row struct {
+	Field       string
+	Type        string
+	Constraints []string
+	Notes       []string
+}
+
+func Rows(rules validator.RulesMap) []Row {
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	rows := make([]Row, len(fields))
+
+	for idx, field := range fields {
+		descriptors := make([]vr.RuleDescriptor, len(rules[field]))
+		for ruleIdx, rule := range rules[field] {
+			descriptors[ruleIdx] = vr.Describe(rule)
+		}
+
+		valueType := typeOf(descriptors)
+
+		row := Row{
+			Field: field,
+			Type:  valueType,
+		}
+
+		for _, descriptor := range descriptors {
+			if isTypeRule(descriptor.Name) {
+				continue
+			}
+
+			switch descriptor.Name {
+			case ve.RuleWhen, ve.RuleMatch, ve.RuleGroup:
+				row.Notes = append(row.Notes, constraint(descriptor, valueType))
+
+			default:
+				row.Constraints = append(row.Constraints, constraint(descriptor, valueType))
+			}
+		}
+
+		rows[idx] = row
+	}
+
+	return rows
+}
+
+func isTypeRule(name string) bool {
+	switch name {
+	case ve.RuleString, ve.RuleInt, ve.RuleFloat, ve.RuleNumeric, ve.RuleBoolean,
+		ve.RuleSlice, ve.RuleSliceOf, ve.RuleArray, ve.RuleArrayOf, ve.RuleMap, ve.RuleStruct, ve.RuleNullable:
+		return true
+
+	default:
+		return false
+	}
+}
+
+func typeOf(descriptors []vr.RuleDescriptor) string {
+	var (
+		valueType string
+		nullable  bool
+	)
+
+	for _, descriptor := range descriptors {
+		switch descriptor.Name {
+		case ve.RuleString:
+			valueType = "string"
+
+		case ve.RuleInt:
+			valueType = fmt.Sprintf("integer (%v)", descriptor.Params["type"])
+
+		case ve.RuleFloat:
+			valueType = fmt.Sprintf("float (%v)", descriptor.Params["type"])
+
+		case ve.RuleNumeric:
+			valueType = "numeric"
+
+		case ve.RuleBoolean:
+			valueType = "boolean"
+
+		case ve.RuleSlice:
+			valueType = "slice"
+
+		case ve.RuleSliceOf:
+			valueType = fmt.Sprintf("slice of %v", descriptor.Params["type"])
+
+		case ve.RuleArray:
+			valueType = "array"
+
+		case ve.RuleArrayOf:
+			valueType = fmt.Sprintf("array of %v", descriptor.Params["type"])
+
+		case ve.RuleMap:
+			valueType = "map"
+
+		case ve.RuleStruct:
+			valueType = "struct"
+
+		case ve.RuleNullable:
+			nullable = true
+		}
+	}
+
+	if valueType == "" {
+		valueType = anyType
+	}
+
+	if nullable {
+		valueType += ", nullable"
+	}
+
+	return valueType
+}
+
+func constraint(descriptor vr.RuleDescriptor, valueType string) string {
+	params := descriptor.Params
+
+	switch descriptor.Name {
+	case ve.RuleRequired:
+		return "required"
+
+	case ve.RulePresent:
+		return "must be present"
+
+	case ve.RuleMissing:
+		return "must be missing"
+
+	case ve.RuleFilled:
+		return "must not be empty"
+
+	case ve.RuleSometimes:
+		return "validated only when present"
+
+	case ve.RuleBail:
+		return "stops on first failure"
+
+	case ve.RuleNullable:
+		return "nullable"
+
+	case ve.RuleMin:
+		return bound(valueType, params["threshold"], params["inclusive"] == true, "at least", "more than", ">=", ">")
+
+	case ve.RuleMax:
+		return bound(valueType, params["threshold"], params["inclusive"] == true, "at most", "less than", "<=", "<")
+
+	case ve.RuleBetween:
+		mode := "exclusive"
+		if params["inclusive"] == true {
+			mode = "inclusive"
+		}
+
+		return fmt.Sprintf("between %v and %v%s (%s)", params["min"], params["max"], unit(valueType), mode)
+
+	case ve.RuleLength:
+		return fmt.Sprintf("exactly %v%s", params["length"], unit(valueType))
+
+	case ve.RuleIn:
+		return "one of: " + list(params["values"])
+
+	case ve.RuleNotIn:
+		return "none of: " + list(params["values"])
+
+	case ve.RuleRegex:
+		return fmt.Sprintf("matches `%v`", params["pattern"])
+
+	case ve.RuleNotRegex:
+		return fmt.Sprintf("does not match `%v`", params["pattern"])
+
+	case ve.RuleStartsWith:
+		return "starts with: " + list(params["prefixes"])
+
+	case ve.RuleDoesntStartWith:
+		return "does not start with: " + list(params["prefixes"])
+
+	case ve.RuleEndsWith:
+		return "ends with: " + list(params["suffixes"])
+
+	case ve.RuleDoesntEndWith:
+		return "does not end with: " + list(params["suffixes"])
+
+	case ve.RuleEmail:
+		return "email address"
+
+	case ve.RuleURL:
+		return "URL"
+
+	case ve.RuleIP:
+		return "IP address"
+
+	case ve.RuleUUID:
+		if versions, _ := params["versions"].([]int); len(versions) > 0 {
+			return "UUID version " + list(versions)
+		}
+
+		return "UUID"
+
+	case ve.RuleDuration:
+		return "duration"
+
+	case ve.RuleDateFormat:
+		return fmt.Sprintf("date in format `%v`", params["format"])
+
+	case ve.RuleAfter:
+		return "after " + formatTime(params["time"])
+
+	case ve.RuleAfterOrEqual:
+		return "after or equal " + formatTime(params["time"])
+
+	case ve.RuleBefore:
+		return "before " + formatTime(params["time"])
+
+	case ve.RuleBeforeOrEqual:
+		return "before or equal " + formatTime(params["time"])
+
+	case ve.RuleDefault:
+		if value, ok := params["value"]; ok {
+			return fmt.Sprintf("defaults to %v", value)
+		}
+
+		return "has a default value"
+
+	case ve.RuleCustom:
+		return "custom validation"
+
+	case ve.RuleNot:
+		return fmt.Sprintf("must not satisfy: %s", constraints(descriptor.Rules, valueType))
+
+	case ve.RuleAllOf:
+		return "all of: " + ruleSets(descriptor.RuleSets, valueType)
+
+	case ve.RuleAnyOf:
+		return "any of: " + ruleSets(descriptor.RuleSets, valueType)
+
+	case ve.RuleOneOf:
+		return "exactly one of: " + ruleSets(descriptor.RuleSets, valueType)
+
+	case ve.RuleEach:
+		return fmt.Sprintf("each element: %s", constraints(descriptor.Rules, anyType))
+
+	case ve.RuleKeys:
+		return fmt.Sprintf("each key: %s", constraints(descriptor.Rules, "string"))
+
+	case ve.RuleNested:
+		return "nested: " + nestedFields(descriptor.Fields)
+
+	case ve.RuleWhen:
+		note := fmt.Sprintf("when the condition is met: %s", constraints(descriptor.Rules, valueType))
+		if len(descriptor.Else) > 0 {
+			note += fmt.Sprintf("; otherwise: %s", constraints(descriptor.Else, valueType))
+		}
+
+		return note
+
+	case ve.RuleMatch:
+		cases := vr.ToSlice(params["cases"])
+		parts := make([]string, 0, len(cases)+1)
+
+		for idx, key := range cases {
+			parts = append(parts, fmt.Sprintf("%v: %s", key, constraints(descriptor.RuleSets[idx], valueType)))
+		}
+
+		if len(descriptor.Else) > 0 {
+			parts = append(parts, fmt.Sprintf("otherwise: %s", constraints(descriptor.Else, valueType)))
+		}
+
+		return "depending on the value: " + strings.Join(parts, "; ")
+
+	case ve.RuleWarn:
+		return fmt.Sprintf("warning if not: %s", constraints(descriptor.Rules, valueType))
+
+	case ve.RuleGroup:
+		return fmt.Sprintf("in groups %s: %s", list(params["groups"]), constraints(descriptor.Rules, valueType))
+
+	case vs.RuleTrim:
+		return fmt.Sprintf("trimmed of %q", params["cutset"])
+
+	case vs.RuleCollapseWhitespace, vs.RuleEmptyToNil, vs.RuleFoldCase, vs.RuleNormalizeNFC,
+		vs.RuleStripTags, vs.RuleToLower, vs.RuleToUpper, vs.RuleTrimSpace:
+		return "sanitized: " + strings.ToLower(strings.ReplaceAll(descriptor.Name, "_", " "))
+
+	default:
+		if isTypeRule(descriptor.Name) {
+			return typeOf([]vr.RuleDescriptor{descriptor})
+		}
+
+		return descriptor.Name
+	}
+}
+
+func constraints(descriptors []vr.RuleDescriptor, valueType string) string {
+	if len(descriptors) == 0 {
+		return "no rules"
+	}
+
+	if subType := typeOf(descriptors); subType != anyType {
+		valueType = subType
+	}
+
+	parts := make([]string, len(descriptors))
+	for idx, descriptor := range descriptors {
+		parts[idx] = constraint(descriptor, valueType)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func ruleSets(sets [][]vr.RuleDescriptor, valueType string) string {
+	parts := make([]string, len(sets))
+	for idx, set := range sets {
+		parts[idx] = "(" + constraints(set, valueType) + ")"
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func nestedFields(fields map[string][]vr.RuleDescriptor) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for idx, name := range names {
+		parts[idx] = fmt.Sprintf("%s (%s)", name, constraints(fields[name], anyType))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func bound(valueType string, threshold any, inclusive bool, inclusiveText, exclusiveText, inclusiveOperator, exclusiveOperator string) string {
+	switch u := unit(valueType); {
+	case u != "":
+		if inclusive {
+			return fmt.Sprintf("%s %v%s", inclusiveText, threshold, u)
+		}
+
+		return fmt.Sprintf("%s %v%s", exclusiveText, threshold, u)
+
+	case inclusive:
+		return fmt.Sprintf("%s %v", inclusiveOperator, threshold)
+
+	default:
+		return fmt.Sprintf("%s %v", exclusiveOperator, threshold)
+	}
+}
+
+func unit(valueType string) string {
+	switch {
+	case strings.HasPrefix(valueType, "string"):
+		return " characters"
+
+	case strings.HasPrefix(valueType, "slice"), strings.HasPrefix(valueType, "array"), strings.HasPrefix(valueType, "map"):
+		return " items"
+
+	default:
+		return ""
+	}
+}
+
+func list(values any) string {
+	items := vr.ToSlice(values)
+
+	parts := make([]string, len(items))
+	for idx, item := range items {
+		parts[idx] = fmt.Sprint(item)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func formatTime(value any) string {
+	if t, ok := value.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	return fmt.Sprint(value)
+}
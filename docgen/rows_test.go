@@ -0,0 +1,143 @@
+package docgen
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+	vs "github.com/donatorsky/go-validator/sanitize"
+)
+
+type undescribableRule struct{}
+
+func (undescribableRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	return value, nil
+}
+
+func Test_Rows(t *testing.T) {
+	// given
+	isCompany := func(_ context.Context, _ any, _ any) bool { return true }
+
+	// when
+	rows := Rows(validator.RulesMap{
+		"name": {
+			vr.Required(),
+			vr.String(),
+			vr.Between(3, 64),
+			vs.TrimSpace(),
+		},
+		"age": {
+			vr.Integer[int](),
+			vr.Nullable(),
+			vr.MinExclusive(17),
+		},
+		"tax_id": {
+			vr.WhenFunc(isCompany, vr.Required(), vr.String(), vr.Length(10)).Else(vr.Missing()),
+		},
+		"tags": {
+			vr.SliceOf[string](),
+			vr.Max(5),
+			vr.Each(vr.String(), vr.Min(2)),
+		},
+		"kind": {
+			vr.In([]string{"person", "company"}),
+		},
+		"score": {
+			vr.Min(1.5),
+		},
+	})
+
+	// then
+	require.Equal(t, []Row{
+		{Field: "age", Type: "integer (int), nullable", Constraints: []string{"> 17"}},
+		{Field: "kind", Type: "any", Constraints: []string{"one of: person, company"}},
+		{Field: "name", Type: "string", Constraints: []string{"required", "between 3 and 64 characters (inclusive)", "sanitized: trim space"}},
+		{Field: "score", Type: "any", Constraints: []string{">= 1.5"}},
+		{Field: "tags", Type: "slice of string", Constraints: []string{"at most 5 items", "each element: string, at least 2 characters"}},
+		{Field: "tax_id", Type: "any", Notes: []string{"when the condition is met: required, string, exactly 10 characters; otherwise: must be missing"}},
+	}, rows)
+}
+
+func Test_constraint(t *testing.T) {
+	moment := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for ttIdx, tt := range []struct {
+		rule               vr.Rule
+		valueType          string
+		expectedConstraint string
+	}{
+		{rule: vr.Present(), expectedConstraint: "must be present"},
+		{rule: vr.Filled(), expectedConstraint: "must not be empty"},
+		{rule: vr.Sometimes(), expectedConstraint: "validated only when present"},
+		{rule: vr.Bail(), expectedConstraint: "stops on first failure"},
+		{rule: vr.Nullable(), expectedConstraint: "nullable"},
+		{rule: vr.Max(3), valueType: "map", expectedConstraint: "at most 3 items"},
+		{rule: vr.MaxExclusive(3), expectedConstraint: "< 3"},
+		{rule: vr.Min(3), valueType: "string", expectedConstraint: "at least 3 characters"},
+		{rule: vr.MinExclusive(3), valueType: "array", expectedConstraint: "more than 3 items"},
+		{rule: vr.BetweenExclusive(1, 2), expectedConstraint: "between 1 and 2 (exclusive)"},
+		{rule: vr.Length(2), valueType: "slice", expectedConstraint: "exactly 2 items"},
+		{rule: vr.NotIn([]int{1, 2}), expectedConstraint: "none of: 1, 2"},
+		{rule: vr.Regex(regexp.MustCompile(`^a|b$`)), expectedConstraint: "matches `^a|b$`"},
+		{rule: vr.NotRegex(regexp.MustCompile(`^a$`)), expectedConstraint: "does not match `^a$`"},
+		{rule: vr.StartsWith("a", "b"), expectedConstraint: "starts with: a, b"},
+		{rule: vr.DoesntStartWith("a"), expectedConstraint: "does not start with: a"},
+		{rule: vr.EndsWith("a"), expectedConstraint: "ends with: a"},
+		{rule: vr.DoesntEndWith("a"), expectedConstraint: "does not end with: a"},
+		{rule: vr.Email(), expectedConstraint: "email address"},
+		{rule: vr.URL(), expectedConstraint: "URL"},
+		{rule: vr.IP(), expectedConstraint: "IP address"},
+		{rule: vr.UUID(), expectedConstraint: "UUID"},
+		{rule: vr.UUID(vr.UUIDRuleVersion4()), expectedConstraint: "UUID version 4"},
+		{rule: vr.Duration(), expectedConstraint: "duration"},
+		{rule: vr.Date(), expectedConstraint: "date in format `" + time.RFC3339Nano + "`"},
+		{rule: vr.After(moment), expectedConstraint: "after 2024-01-02T03:04:05Z"},
+		{rule: vr.AfterOrEqual(moment), expectedConstraint: "after or equal 2024-01-02T03:04:05Z"},
+		{rule: vr.Before(moment), expectedConstraint: "before 2024-01-02T03:04:05Z"},
+		{rule: vr.BeforeOrEqual(moment), expectedConstraint: "before or equal 2024-01-02T03:04:05Z"},
+		{rule: vr.Default("x"), expectedConstraint: "defaults to x"},
+		{rule: vr.DefaultFunc(func(_ context.Context, _ any) any { return nil }), expectedConstraint: "has a default value"},
+		{rule: vr.Custom(func(_ context.Context, value any, _ any) (any, error) { return value, nil }), expectedConstraint: "custom validation"},
+		{rule: vr.Not(vr.In([]string{"a"})), expectedConstraint: "must not satisfy: one of: a"},
+		{rule: vr.AllOf([]vr.Rule{vr.String()}, []vr.Rule{vr.Filled()}), expectedConstraint: "all of: (string) (must not be empty)"},
+		{rule: vr.AnyOf([]vr.Rule{vr.String(), vr.Min(2)}, []vr.Rule{vr.Integer[int](), vr.Min(2)}), expectedConstraint: "any of: (string, at least 2 characters) (integer (int), >= 2)"},
+		{rule: vr.OneOf([]vr.Rule{vr.Map()}, nil), expectedConstraint: "exactly one of: (map) (no rules)"},
+		{rule: vr.Keys(vr.Max(3)), expectedConstraint: "each key: at most 3 characters"},
+		{rule: vr.Nested(map[string][]vr.Rule{"b": {vr.Required()}, "a": {vr.String()}}), expectedConstraint: "nested: a (string), b (required)"},
+		{rule: vr.Warn(vr.Max(100)), expectedConstraint: "warning if not: <= 100"},
+		{rule: vr.When(true, vr.Required()), expectedConstraint: "when the condition is met: required"},
+		{rule: vr.Group([]string{"admin", "editor"}, vr.Required()), expectedConstraint: "in groups admin, editor: required"},
+		{
+			rule: vr.Match(func(_ context.Context, _ any, _ any) string { return "" }).
+				Case("b", vr.Required()).
+				Case("a", vr.String()).
+				Default(vr.Missing()),
+			expectedConstraint: "depending on the value: a: string; b: required; otherwise: must be missing",
+		},
+		{rule: vs.Trim("-"), expectedConstraint: `trimmed of "-"`},
+		{rule: vs.ToUpper(), expectedConstraint: "sanitized: to upper"},
+		{rule: vr.Boolean(), expectedConstraint: "boolean"},
+		{rule: undescribableRule{}, expectedConstraint: "docgen.undescribableRule"},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// given
+			valueType := tt.valueType
+			if valueType == "" {
+				valueType = anyType
+			}
+
+			// when
+			actual := constraint(vr.Describe(tt.rule), valueType)
+
+			// then
+			require.Equal(t, tt.expectedConstraint, actual)
+		})
+	}
+}
@@ -3,40 +3,57 @@ package error
 const (
 	RuleAfter           = "AFTER"
 	RuleAfterOrEqual    = "AFTER_OR_EQUAL"
+	RuleAllOf           = "ALL_OF"
+	RuleAnyOf           = "ANY_OF"
 	RuleArray           = "ARRAY"
 	RuleArrayOf         = "ARRAY_OF"
+	RuleBail            = "BAIL"
 	RuleBefore          = "BEFORE"
 	RuleBeforeOrEqual   = "BEFORE_OR_EQUAL"
 	RuleBetween         = "BETWEEN"
 	RuleBoolean         = "BOOLEAN"
 	RuleCustom          = "CUSTOM"
 	RuleDateFormat      = "DATE_FORMAT"
+	RuleDefault         = "DEFAULT"
 	RuleDoesntEndWith   = "DOESNT_END_WITH"
 	RuleDoesntStartWith = "DOESNT_START_WITH"
 	RuleDuration        = "DURATION"
+	RuleEach            = "EACH"
 	RuleEmail           = "EMAIL"
 	RuleEndsWith        = "ENDS_WITH"
 	RuleFilled          = "FILLED"
 	RuleFloat           = "FLOAT"
+	RuleGroup           = "GROUP"
 	RuleIn              = "IN"
 	RuleInt             = "INT"
 	RuleIP              = "IP"
+	RuleKeys            = "KEYS"
 	RuleLength          = "LENGTH"
 	RuleMap             = "MAP"
+	RuleMatch           = "MATCH"
 	RuleMax             = "MAX"
 	RuleMin             = "MIN"
+	RuleMissing         = "MISSING"
+	RuleNested          = "NESTED"
+	RuleNot             = "NOT"
 	RuleNotIn           = "NOT_IN"
 	RuleNotRegex        = "NOT_REGEX"
+	RuleNullable        = "NULLABLE"
 	RuleNumeric         = "NUMERIC"
+	RuleOneOf           = "ONE_OF"
+	RulePresent         = "PRESENT"
 	RuleRegex           = "REGEX"
 	RuleRequired        = "REQUIRED"
 	RuleSlice           = "SLICE"
 	RuleSliceOf         = "SLICE_OF"
+	RuleSometimes       = "SOMETIMES"
 	RuleStartsWith      = "STARTS_WITH"
 	RuleString          = "STRING"
 	RuleStruct          = "STRUCT"
 	RuleURL             = "URL"
 	RuleUUID            = "UUID"
+	RuleWarn            = "WARN"
+	RuleWhen            = "WHEN"
 )
 
 const (
@@ -20,7 +20,27 @@ func (b ErrorsBag) Add(field string, errors ...ValidationError) {
 }
 
 func (b ErrorsBag) Any() bool {
-	return len(b) > 0
+	for _, errors := range b {
+		for _, validationError := range errors {
+			if SeverityOf(validationError) == SeverityError {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (b ErrorsBag) Errors() ErrorsBag {
+	return b.withSeverity(SeverityError)
+}
+
+func (b ErrorsBag) Warnings() ErrorsBag {
+	return b.withSeverity(SeverityWarning)
+}
+
+func (b ErrorsBag) Infos() ErrorsBag {
+	return b.withSeverity(SeverityInfo)
 }
 
 func (b ErrorsBag) All() map[string][]ValidationError {
@@ -42,7 +62,7 @@ func (b ErrorsBag) Get(field string) []ValidationError {
 }
 
 func (b ErrorsBag) Error() string {
-	message := fmt.Sprintf("%d field(s) failed:", len(b))
+	message := fmt.Sprintf("%d field(s) failed:", len(b.Errors()))
 
 	for field, errors := range b {
 		messages := make([]string, len(errors))
@@ -55,3 +75,17 @@ func (b ErrorsBag) Error() string {
 
 	return message
 }
+
+func (b ErrorsBag) withSeverity(severity Severity) ErrorsBag {
+	filtered := NewErrorsBag()
+
+	for field, errors := range b {
+		for _, validationError := range errors {
+			if SeverityOf(validationError) == severity {
+				filtered.Add(field, validationError)
+			}
+		}
+	}
+
+	return filtered
+}
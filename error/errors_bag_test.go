@@ -148,3 +148,33 @@ func Test_NewErrorsBag(t *testing.T) {
 		error3MessageDummy,
 	))
 }
+
+func Test_ErrorsBag_Severities(t *testing.T) {
+	// given
+	var (
+		err     = NewCustomMessageValidationError("ERROR", "error")
+		warning = NewSeverityValidationError(NewCustomMessageValidationError("WARNING", "warning"), SeverityWarning)
+		info    = NewSeverityValidationError(NewCustomMessageValidationError("INFO", "info"), SeverityInfo)
+	)
+
+	errorsBag := ErrorsBag{
+		"field1": {err, warning},
+		"field2": {info},
+	}
+
+	// then
+	require.True(t, errorsBag.Any())
+	require.Equal(t, ErrorsBag{"field1": {err}}, errorsBag.Errors())
+	require.Equal(t, ErrorsBag{"field1": {warning}}, errorsBag.Warnings())
+	require.Equal(t, ErrorsBag{"field2": {info}}, errorsBag.Infos())
+	require.Contains(t, errorsBag.Error(), "1 field(s) failed:")
+
+	// and when
+	delete(errorsBag, "field1")
+
+	// then
+	require.False(t, errorsBag.Any())
+	require.Empty(t, errorsBag.Errors())
+	require.Empty(t, errorsBag.Warnings())
+	require.Contains(t, errorsBag.Error(), "0 field(s) failed:")
+}
@@ -0,0 +1,25 @@
+package error
+
+func NewFieldValidationError(field string, err ValidationError) FieldValidationError {
+	return FieldValidationError{
+		Field: field,
+		Err:   err,
+	}
+}
+
+type FieldValidationError struct {
+	Field string          `json:"field"`
+	Err   ValidationError `json:"error"`
+}
+
+func (e FieldValidationError) GetRule() string {
+	return e.Err.GetRule()
+}
+
+func (e FieldValidationError) GetSeverity() Severity {
+	return SeverityOf(e.Err)
+}
+
+func (e FieldValidationError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
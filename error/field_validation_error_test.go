@@ -0,0 +1,36 @@
+package error
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFieldValidationError(t *testing.T) {
+	var fakerInstance = faker.New()
+
+	// given
+	var (
+		fieldDummy   = fakerInstance.Lorem().Word()
+		ruleDummy    = fakerInstance.Lorem().Word()
+		messageDummy = fakerInstance.Lorem().Sentence(6)
+	)
+
+	fve := NewFieldValidationError(fieldDummy, NewCustomMessageValidationError(ruleDummy, messageDummy))
+
+	// then
+	require.Equal(t, ruleDummy, fve.GetRule())
+	require.EqualError(t, fve, fieldDummy+": "+messageDummy)
+	require.Equal(t, SeverityError, fve.GetSeverity())
+}
+
+func TestFieldValidationError_GetSeverity(t *testing.T) {
+	// given
+	err := NewCustomMessageValidationError("RULE", "message")
+
+	// then
+	require.Equal(t, SeverityError, SeverityOf(NewFieldValidationError("field", err)))
+	require.Equal(t, SeverityWarning, SeverityOf(NewFieldValidationError("field", NewSeverityValidationError(err, SeverityWarning))))
+	require.Equal(t, SeverityInfo, SeverityOf(NewFieldValidationError("field", NewFieldValidationError("nested", NewSeverityValidationError(err, SeverityInfo)))))
+}
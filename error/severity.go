@@ -0,0 +1,88 @@
+package error
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
+)
+
+type Severity uint8
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+
+	case SeverityWarning:
+		return "warning"
+
+	case SeverityInfo:
+		return "info"
+
+	default:
+		return "unknown"
+	}
+}
+
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+type SeverityAwareError interface {
+	ValidationError
+
+	GetSeverity() Severity
+}
+
+func SeverityOf(err ValidationError) Severity {
+	if severityAwareError, ok := err.(SeverityAwareError); ok {
+		return severityAwareError.GetSeverity()
+	}
+
+	return SeverityError
+}
+
+func WithSeverity(err ValidationError, severity Severity) ValidationError {
+	switch validationError := err.(type) {
+	case nil:
+		return nil
+
+	case ValidationErrors:
+		errors := make(ValidationErrors, len(validationError))
+		for idx, err := range validationError {
+			errors[idx] = WithSeverity(err, severity)
+		}
+
+		return errors
+
+	case SeverityValidationError:
+		return NewSeverityValidationError(validationError.Err, severity)
+
+	default:
+		return NewSeverityValidationError(err, severity)
+	}
+}
+
+func NewSeverityValidationError(err ValidationError, severity Severity) SeverityValidationError {
+	return SeverityValidationError{
+		Err:      err,
+		Severity: severity,
+	}
+}
+
+type SeverityValidationError struct {
+	Err      ValidationError `json:"error"`
+	Severity Severity        `json:"severity"`
+}
+
+func (e SeverityValidationError) GetRule() string {
+	return e.Err.GetRule()
+}
+
+func (e SeverityValidationError) GetSeverity() Severity {
+	return e.Severity
+}
+
+func (e SeverityValidationError) Error() string {
+	return e.Err.Error()
+}
@@ -0,0 +1,92 @@
+package error
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverity_String(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		severity       Severity
+		expectedString string
+	}{
+		{severity: SeverityError, expectedString: "error"},
+		{severity: SeverityWarning, expectedString: "warning"},
+		{severity: SeverityInfo, expectedString: "info"},
+		{severity: Severity(100), expectedString: "unknown"},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// then
+			require.Equal(t, tt.expectedString, tt.severity.String())
+		})
+	}
+}
+
+func TestSeverityOf(t *testing.T) {
+	// given
+	err := NewCustomMessageValidationError("RULE", "message")
+
+	// then
+	require.Equal(t, SeverityError, SeverityOf(err))
+	require.Equal(t, SeverityWarning, SeverityOf(NewSeverityValidationError(err, SeverityWarning)))
+	require.Equal(t, SeverityInfo, SeverityOf(NewSeverityValidationError(err, SeverityInfo)))
+}
+
+func TestWithSeverity(t *testing.T) {
+	var (
+		err1 = NewCustomMessageValidationError("RULE1", "message 1")
+		err2 = NewCustomMessageValidationError("RULE2", "message 2")
+	)
+
+	for ttIdx, tt := range []struct {
+		err           ValidationError
+		expectedError ValidationError
+	}{
+		{
+			err:           nil,
+			expectedError: nil,
+		},
+		{
+			err:           err1,
+			expectedError: NewSeverityValidationError(err1, SeverityWarning),
+		},
+		{
+			err:           NewSeverityValidationError(err1, SeverityInfo),
+			expectedError: NewSeverityValidationError(err1, SeverityWarning),
+		},
+		{
+			err: ValidationErrors{err1, err2},
+			expectedError: ValidationErrors{
+				NewSeverityValidationError(err1, SeverityWarning),
+				NewSeverityValidationError(err2, SeverityWarning),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			err := WithSeverity(tt.err, SeverityWarning)
+
+			// then
+			require.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestSeverityValidationError(t *testing.T) {
+	// given
+	err := NewSeverityValidationError(NewCustomMessageValidationError("RULE", "message"), SeverityWarning)
+
+	// when
+	encoded, encodingErr := json.Marshal(err)
+
+	// then
+	require.Equal(t, "RULE", err.GetRule())
+	require.Equal(t, SeverityWarning, err.GetSeverity())
+	require.EqualError(t, err, "message")
+
+	require.NoError(t, encodingErr)
+	require.JSONEq(t, `{"error":{"rule":"RULE","message":"message"},"severity":"warning"}`, string(encoded))
+}
@@ -0,0 +1,12 @@
+package error
+
+import "fmt"
+
+type UnreachableFieldError struct {
+	Type     string
+	Selector int
+}
+
+func (e UnreachableFieldError) Error() string {
+	return fmt.Sprintf("field selector #%d of %s points to an unreachable field", e.Selector, e.Type)
+}
@@ -0,0 +1,27 @@
+package error
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UnreachableFieldError_Error(t *testing.T) {
+	fakerInstance := faker.New()
+
+	// given
+	var (
+		typeDummy     = fakerInstance.Lorem().Sentence(3)
+		selectorDummy = fakerInstance.IntBetween(1, 100)
+
+		err = UnreachableFieldError{
+			Type:     typeDummy,
+			Selector: selectorDummy,
+		}
+	)
+
+	// then
+	require.EqualError(t, err, fmt.Sprintf("field selector #%d of %s points to an unreachable field", selectorDummy, typeDummy))
+}
@@ -0,0 +1,57 @@
+package error
+
+import (
+	"strings"
+)
+
+func Join(errors ...ValidationError) ValidationError {
+	var joined ValidationErrors
+
+	for _, err := range errors {
+		joined = append(joined, Flatten(err)...)
+	}
+
+	if len(joined) == 0 {
+		return nil
+	}
+
+	return joined
+}
+
+func Flatten(err ValidationError) []ValidationError {
+	switch validationErrors := err.(type) {
+	case nil:
+		return nil
+
+	case ValidationErrors:
+		var flattened []ValidationError
+
+		for _, validationError := range validationErrors {
+			flattened = append(flattened, Flatten(validationError)...)
+		}
+
+		return flattened
+
+	default:
+		return []ValidationError{err}
+	}
+}
+
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) GetRule() string {
+	if len(e) == 0 {
+		return ""
+	}
+
+	return e[0].GetRule()
+}
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for idx, validationError := range e {
+		messages[idx] = validationError.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,96 @@
+package error
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoin(t *testing.T) {
+	var (
+		err1 = NewCustomMessageValidationError("RULE1", "message 1")
+		err2 = NewCustomMessageValidationError("RULE2", "message 2")
+		err3 = NewCustomMessageValidationError("RULE3", "message 3")
+	)
+
+	for ttIdx, tt := range []struct {
+		errors        []ValidationError
+		expectedError ValidationError
+	}{
+		{
+			errors:        nil,
+			expectedError: nil,
+		},
+		{
+			errors:        []ValidationError{nil, ValidationErrors{}},
+			expectedError: nil,
+		},
+		{
+			errors:        []ValidationError{err1},
+			expectedError: ValidationErrors{err1},
+		},
+		{
+			errors:        []ValidationError{err1, nil, ValidationErrors{err2, ValidationErrors{err3}}},
+			expectedError: ValidationErrors{err1, err2, err3},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			err := Join(tt.errors...)
+
+			// then
+			require.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	var (
+		err1 = NewCustomMessageValidationError("RULE1", "message 1")
+		err2 = NewCustomMessageValidationError("RULE2", "message 2")
+	)
+
+	for ttIdx, tt := range []struct {
+		err            ValidationError
+		expectedErrors []ValidationError
+	}{
+		{
+			err:            nil,
+			expectedErrors: nil,
+		},
+		{
+			err:            err1,
+			expectedErrors: []ValidationError{err1},
+		},
+		{
+			err:            ValidationErrors{},
+			expectedErrors: nil,
+		},
+		{
+			err:            ValidationErrors{ValidationErrors{err1}, err2},
+			expectedErrors: []ValidationError{err1, err2},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			errors := Flatten(tt.err)
+
+			// then
+			require.Equal(t, tt.expectedErrors, errors)
+		})
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	// given
+	errors := ValidationErrors{
+		NewCustomMessageValidationError("RULE1", "message 1"),
+		NewCustomMessageValidationError("RULE2", "message 2"),
+	}
+
+	// then
+	require.Equal(t, "RULE1", errors.GetRule())
+	require.EqualError(t, errors, "message 1; message 2")
+	require.Equal(t, "", ValidationErrors{}.GetRule())
+}
@@ -1,11 +1,7 @@
 package validator
 
 import (
-	"reflect"
-	"strconv"
-	"strings"
-
-	vr "github.com/donatorsky/go-validator/rule"
+	"github.com/donatorsky/go-validator/internal/engine"
 )
 
 func newFieldsIterator(field string, data any) <-chan fieldValue {
@@ -14,114 +10,22 @@ func newFieldsIterator(field string, data any) <-chan fieldValue {
 	go func() {
 		defer close(fieldsValues)
 
-		fieldParts := strings.Split(field, ".")
-
-		iterateOverFieldPart(fieldsValues, make([]string, len(fieldParts)), fieldParts, 0, data)
+		engine.WalkField(field, data, func(value engine.FieldValue) {
+			fieldsValues <- fieldValue{
+				field:   value.Field,
+				value:   value.Value,
+				missing: value.Missing,
+				groups:  value.Groups,
+			}
+		})
 	}()
 
 	return fieldsValues
 }
 
 type fieldValue struct {
-	field string
-	value any
-}
-
-func iterateOverFieldPart(fieldsValues chan<- fieldValue, fieldName []string, fieldParts []string, position int, value any) {
-	isNil := false
-	if value == nil {
-		isNil = true
-	} else {
-		value, isNil = vr.Dereference(value)
-	}
-
-	if isNil {
-		for idx := position; idx < len(fieldParts); idx++ {
-			fieldName[idx] = fieldParts[idx]
-		}
-
-		fieldsValues <- fieldValue{
-			field: strings.Join(fieldName, "."),
-			value: nil,
-		}
-
-		return
-	}
-
-	if len(fieldParts) == position {
-		fieldsValues <- fieldValue{
-			field: strings.Join(fieldName, "."),
-			value: value,
-		}
-
-		return
-	}
-
-	valueOf := reflect.ValueOf(value)
-
-	if fieldParts[position] == "*" {
-		if valueOf.Kind() == reflect.Slice || valueOf.Kind() == reflect.Array {
-			for idx := 0; idx < valueOf.Len(); idx++ {
-				fieldName[position] = strconv.Itoa(idx)
-
-				iterateOverFieldPart(fieldsValues, fieldName, fieldParts, position+1, valueOf.Index(idx).Interface())
-			}
-
-			return
-		}
-
-		for idx := position; idx < len(fieldParts); idx++ {
-			fieldName[idx] = fieldParts[idx]
-		}
-
-		fieldsValues <- fieldValue{
-			value: nil,
-			field: strings.Join(fieldName, "."),
-		}
-
-		return
-	}
-
-	switch valueOf.Kind() {
-	case reflect.Map:
-		mapIndex := valueOf.MapIndex(reflect.ValueOf(fieldParts[position]))
-		if mapIndex.IsValid() {
-			value = mapIndex.Interface()
-		} else {
-			value = nil
-		}
-
-	case reflect.Struct:
-		fieldByName := valueOf.FieldByName(fieldParts[position])
-		if fieldByName.IsValid() {
-			value = fieldByName.Interface()
-		} else {
-			typeOf := reflect.TypeOf(value)
-			value = nil
-			for idx := 0; idx < typeOf.NumField(); idx++ {
-				structField := typeOf.Field(idx)
-				nameFromTag := structField.Tag.Get("validation")
-				if nameFromTag != "" && nameFromTag == fieldParts[position] {
-					value = valueOf.FieldByName(structField.Name).Interface()
-				}
-			}
-		}
-
-	case reflect.Slice, reflect.Array:
-		idx, err := strconv.Atoi(fieldParts[position])
-		if err != nil || idx < 0 || idx >= valueOf.Len() {
-			value = nil
-
-			break
-		}
-
-		value = valueOf.Index(idx).Interface()
-
-	default:
-		value = nil
-	}
-
-	fieldName[position] = fieldParts[position]
-
-	iterateOverFieldPart(fieldsValues, fieldName, fieldParts, position+1, value)
+	field   string
+	value   any
+	missing bool
+	groups  [][]string
 }
@@ -251,8 +251,9 @@ func invalidValueTestCaseDataProvider() (tcd []testCaseData) {
 				data:  data,
 				expectedValues: []fieldValue{
 					{
-						field: field,
-						value: nil,
+						field:   field,
+						value:   nil,
+						missing: true,
 					},
 				},
 			})
@@ -264,13 +265,24 @@ func invalidValueTestCaseDataProvider() (tcd []testCaseData) {
 
 func sliceTestCaseDataProvider() []testCaseData {
 	return []testCaseData{
+		{
+			field: "0",
+			data:  []any{nil},
+			expectedValues: []fieldValue{
+				{
+					field: "0",
+					value: nil,
+				},
+			},
+		},
 		{
 			field: "foo",
 			data:  ([]any)(nil),
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -279,8 +291,9 @@ func sliceTestCaseDataProvider() []testCaseData {
 			data:  []any{"foo"},
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -289,8 +302,9 @@ func sliceTestCaseDataProvider() []testCaseData {
 			data:  ptr([]any{"foo"}),
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -319,8 +333,9 @@ func sliceTestCaseDataProvider() []testCaseData {
 			data:  []any{"foo"},
 			expectedValues: []fieldValue{
 				{
-					field: "1",
-					value: nil,
+					field:   "1",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -329,8 +344,9 @@ func sliceTestCaseDataProvider() []testCaseData {
 			data:  ptr([]any{"foo"}),
 			expectedValues: []fieldValue{
 				{
-					field: "1",
-					value: nil,
+					field:   "1",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -387,12 +403,14 @@ func sliceTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "0.foo",
-					value: nil,
+					field:   "0.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "1.foo",
-					value: nil,
+					field:   "1.foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -454,20 +472,24 @@ func sliceTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "0.0.foo",
-					value: nil,
+					field:   "0.0.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "0.1.foo",
-					value: nil,
+					field:   "0.1.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "1.0.foo",
-					value: nil,
+					field:   "1.0.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "1.1.foo",
-					value: nil,
+					field:   "1.1.foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -481,8 +503,9 @@ func arrayTestCaseDataProvider() []testCaseData {
 			data:  [0]any{},
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -491,8 +514,9 @@ func arrayTestCaseDataProvider() []testCaseData {
 			data:  ptr([0]any{}),
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -501,8 +525,9 @@ func arrayTestCaseDataProvider() []testCaseData {
 			data:  [1]any{"foo"},
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -511,8 +536,9 @@ func arrayTestCaseDataProvider() []testCaseData {
 			data:  ptr([1]any{"foo"}),
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -541,8 +567,9 @@ func arrayTestCaseDataProvider() []testCaseData {
 			data:  [1]any{"foo"},
 			expectedValues: []fieldValue{
 				{
-					field: "1",
-					value: nil,
+					field:   "1",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -551,8 +578,9 @@ func arrayTestCaseDataProvider() []testCaseData {
 			data:  ptr([1]any{"foo"}),
 			expectedValues: []fieldValue{
 				{
-					field: "1",
-					value: nil,
+					field:   "1",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -609,12 +637,14 @@ func arrayTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "0.foo",
-					value: nil,
+					field:   "0.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "1.foo",
-					value: nil,
+					field:   "1.foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -676,20 +706,24 @@ func arrayTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "0.0.foo",
-					value: nil,
+					field:   "0.0.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "0.1.foo",
-					value: nil,
+					field:   "0.1.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "1.0.foo",
-					value: nil,
+					field:   "1.0.foo",
+					value:   nil,
+					missing: true,
 				},
 				{
-					field: "1.1.foo",
-					value: nil,
+					field:   "1.1.foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -698,30 +732,97 @@ func arrayTestCaseDataProvider() []testCaseData {
 
 func mapTestCaseDataProvider() []testCaseData {
 	return []testCaseData{
+		{
+			field: "1",
+			data: map[int]string{
+				1: "foo",
+			},
+			expectedValues: []fieldValue{
+				{
+					field: "1",
+					value: "foo",
+				},
+			},
+		},
 		{
 			field: "foo",
-			data:  (map[string]any)(nil),
+			data: map[int]string{
+				1: "foo",
+			},
+			expectedValues: []fieldValue{
+				{
+					field:   "foo",
+					value:   nil,
+					missing: true,
+				},
+			},
+		},
+		{
+			field: "1",
+			data: map[uint8]string{
+				1: "foo",
+			},
+			expectedValues: []fieldValue{
+				{
+					field: "1",
+					value: "foo",
+				},
+			},
+		},
+		{
+			field: "foo",
+			data: map[someKey]string{
+				"foo": "foo",
+			},
 			expectedValues: []fieldValue{
 				{
 					field: "foo",
-					value: nil,
+					value: "foo",
 				},
 			},
 		},
 		{
 			field: "foo",
-			data:  map[string]any{},
+			data: map[any]string{
+				"foo": "foo",
+			},
 			expectedValues: []fieldValue{
 				{
 					field: "foo",
-					value: nil,
+					value: "foo",
+				},
+			},
+		},
+		{
+			field: "foo",
+			data: map[fmt.Stringer]string{
+				nil: "foo",
+			},
+			expectedValues: []fieldValue{
+				{
+					field:   "foo",
+					value:   nil,
+					missing: true,
+				},
+			},
+		},
+		{
+			field: "1.5",
+			data: map[float64]string{
+				1.5: "foo",
+			},
+			expectedValues: []fieldValue{
+				{
+					field:   "1.5",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
 		{
 			field: "foo",
 			data: map[string]any{
-				"bar": "baz",
+				"foo": nil,
 			},
 			expectedValues: []fieldValue{
 				{
@@ -730,6 +831,54 @@ func mapTestCaseDataProvider() []testCaseData {
 				},
 			},
 		},
+		{
+			field: "foo.bar",
+			data: map[string]any{
+				"foo": nil,
+			},
+			expectedValues: []fieldValue{
+				{
+					field:   "foo.bar",
+					value:   nil,
+					missing: true,
+				},
+			},
+		},
+		{
+			field: "foo",
+			data:  (map[string]any)(nil),
+			expectedValues: []fieldValue{
+				{
+					field:   "foo",
+					value:   nil,
+					missing: true,
+				},
+			},
+		},
+		{
+			field: "foo",
+			data:  map[string]any{},
+			expectedValues: []fieldValue{
+				{
+					field:   "foo",
+					value:   nil,
+					missing: true,
+				},
+			},
+		},
+		{
+			field: "foo",
+			data: map[string]any{
+				"bar": "baz",
+			},
+			expectedValues: []fieldValue{
+				{
+					field:   "foo",
+					value:   nil,
+					missing: true,
+				},
+			},
+		},
 		{
 			field: "foo",
 			data: ptr(map[string]any{
@@ -737,8 +886,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			}),
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -773,8 +923,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "0",
-					value: nil,
+					field:   "0",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -785,8 +936,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			}),
 			expectedValues: []fieldValue{
 				{
-					field: "0",
-					value: nil,
+					field:   "0",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -797,8 +949,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "*",
-					value: nil,
+					field:   "*",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -809,8 +962,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			}),
 			expectedValues: []fieldValue{
 				{
-					field: "*",
-					value: nil,
+					field:   "*",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -821,8 +975,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "*.0",
-					value: nil,
+					field:   "*.0",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -833,8 +988,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "*.foo",
-					value: nil,
+					field:   "*.foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -845,8 +1001,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "*.*",
-					value: nil,
+					field:   "*.*",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -873,8 +1030,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "foo.*",
-					value: nil,
+					field:   "foo.*",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -921,8 +1079,9 @@ func mapTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "foo.bar.*",
-					value: nil,
+					field:   "foo.bar.*",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -931,13 +1090,43 @@ func mapTestCaseDataProvider() []testCaseData {
 
 func structTestCaseDataProvider() []testCaseData {
 	return []testCaseData{
+		{
+			field: "grouped.grouped.Foo",
+			data: someStruct{
+				Grouped: &someStruct{
+					Grouped: &someStruct{
+						Foo: "Foo",
+					},
+				},
+			},
+			expectedValues: []fieldValue{
+				{
+					field:  "grouped.grouped.Foo",
+					value:  "Foo",
+					groups: [][]string{{"foo", "bar"}, {"foo", "bar"}},
+				},
+			},
+		},
+		{
+			field: "grouped.Foo",
+			data:  someStruct{},
+			expectedValues: []fieldValue{
+				{
+					field:   "grouped.Foo",
+					value:   nil,
+					missing: true,
+					groups:  [][]string{{"foo", "bar"}},
+				},
+			},
+		},
 		{
 			field: "foo",
 			data:  (*someStruct)(nil),
 			expectedValues: []fieldValue{
 				{
-					field: "foo",
-					value: nil,
+					field:   "foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -946,8 +1135,9 @@ func structTestCaseDataProvider() []testCaseData {
 			data:  (*someStruct)(nil),
 			expectedValues: []fieldValue{
 				{
-					field: "Foo",
-					value: nil,
+					field:   "Foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -1022,8 +1212,9 @@ func structTestCaseDataProvider() []testCaseData {
 			},
 			expectedValues: []fieldValue{
 				{
-					field: "Struct.Foo",
-					value: nil,
+					field:   "Struct.Foo",
+					value:   nil,
+					missing: true,
 				},
 			},
 		},
@@ -1410,12 +1601,15 @@ func mixedTestCaseDataProvider() []testCaseData {
 	}
 }
 
+type someKey string
+
 type someStruct struct {
 	Foo         string
 	FooNamed    string `validation:"foo"`
 	Struct      *someStruct
 	StructNamed *someStruct `validation:"struct"`
 	Any         any         `validation:"any"`
+	Grouped     *someStruct `validation:"grouped" validationGroups:"foo, bar"`
 }
 
 func getType(v any) string {
@@ -2,8 +2,10 @@ package validator
 
 import (
 	"context"
+	"time"
 
 	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
 )
 
 type forMapValidatorOption func(options *validatorOptions) error
@@ -21,16 +23,39 @@ func ForMapWithContext(ctx context.Context, data map[string]any, rules RulesMap,
 		}
 	}
 
+	if opts.groups != nil {
+		ctx = vr.ContextWithGroups(ctx, opts.groups...)
+	}
+
+	if len(opts.before) > 0 {
+		preparedData, err := opts.applyBefore(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+
+		data = preparedData.(map[string]any)
+	}
+
 	errorsBag := ve.NewErrorsBag()
 
+	defer opts.validationDone(ctx, errorsBag, time.Now())
+
 	for field, rules := range rules {
 		for fieldValue := range newFieldsIterator(field, data) {
+			if opts.partial && fieldValue.missing {
+				continue
+			}
+
 			if err := applyRules(ctx, data, rules, fieldValue, errorsBag, opts); err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	applyObjectRules(ctx, data, opts.objectRules, errorsBag)
+
+	opts.applyAfter(ctx, data, errorsBag)
+
 	return errorsBag, nil
 }
 
@@ -41,3 +66,63 @@ func ForMapWithDataCollector(collector DataCollector) forMapValidatorOption {
 		return nil
 	}
 }
+
+func ForMapWithPartialValidation() forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.partial = true
+
+		return nil
+	}
+}
+
+func ForMapWithGroups(groups ...string) forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.groups = groups
+
+		return nil
+	}
+}
+
+func ForMapWithTrace(trace *Trace) forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.trace = trace
+
+		return nil
+	}
+}
+
+func ForMapWithHooks(hooks Hooks) forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.hooks = hooks
+
+		return nil
+	}
+}
+
+func ForMapWithBefore(hook func(ctx context.Context, data map[string]any) (map[string]any, error)) forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.before = append(options.before, func(ctx context.Context, data any) (any, error) {
+			return hook(ctx, data.(map[string]any))
+		})
+
+		return nil
+	}
+}
+
+func ForMapWithAfter(hook func(ctx context.Context, data map[string]any, errorsBag ve.ErrorsBag)) forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.after = append(options.after, func(ctx context.Context, data any, errorsBag ve.ErrorsBag) {
+			hook(ctx, data.(map[string]any), errorsBag)
+		})
+
+		return nil
+	}
+}
+
+func ForMapWithObjectRules(rules ...ObjectRule) forMapValidatorOption {
+	return func(options *validatorOptions) error {
+		options.objectRules = append(options.objectRules, rules...)
+
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -10,6 +11,7 @@ import (
 
 	ve "github.com/donatorsky/go-validator/error"
 	vr "github.com/donatorsky/go-validator/rule"
+	vs "github.com/donatorsky/go-validator/sanitize"
 )
 
 func Test_ForMap(t *testing.T) {
@@ -250,3 +252,689 @@ func Test_ForMapWithContext_WithDataCollector(t *testing.T) {
 		require.True(t, assertCollectorHasValue(t, collector, "slice.2", data["slice"].([]int)[2]))
 	})
 }
+
+func Test_ForMapWithContext_Presence(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"null":           nil,
+			"null sometimes": nil,
+			"value":          fakerInstance.Int(),
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"null": {
+			vr.Present(),
+			vr.Nullable(),
+			vr.Required(),
+		},
+		"missing present": {
+			vr.Present(),
+			vr.Required(),
+		},
+		"missing sometimes": {
+			vr.Sometimes(),
+			vr.Required(),
+		},
+		"null sometimes": {
+			vr.Sometimes(),
+			vr.Required(),
+		},
+		"value": {
+			vr.Missing(),
+		},
+		"missing": {
+			vr.Missing(),
+		},
+	}, ForMapWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 3)
+
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewPresentValidationError()}, "missing present"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "null sometimes"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewMissingValidationError()}, "value"))
+
+	require.True(t, assertCollectorHasValue(t, collector, "null", nil))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "missing sometimes"))
+	require.True(t, assertCollectorHasValue(t, collector, "missing", nil))
+}
+
+func Test_ForMapWithContext_WithPartialValidation(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"value": nil,
+			"slice": []any{
+				map[string]any{"foo": nil},
+				map[string]any{"bar": fakerInstance.Int()},
+			},
+		}
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"value": {
+			vr.Required(),
+		},
+		"non-existing value": {
+			vr.Required(),
+		},
+		"slice.*.foo": {
+			vr.Required(),
+		},
+		"slice.*.bar": {
+			vr.Required(),
+		},
+		"non-existing slice.*": {
+			vr.Required(),
+		},
+	}, ForMapWithPartialValidation())
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 2)
+
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "value"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "slice.0.foo"))
+}
+
+func Test_ForMapWithContext_WithGroups(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"value": nil,
+		}
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"value": {
+			vr.Group([]string{"update", "admin"},
+				vr.Missing(),
+			),
+			vr.Group([]string{"create"},
+				vr.Required(),
+			),
+			vr.Group([]string{"other"},
+				vr.Filled(),
+			),
+		},
+	}, ForMapWithGroups("create", "admin"))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{
+		vr.NewMissingValidationError(),
+		vr.NewRequiredValidationError(),
+	}, "value"))
+}
+
+func Test_ForMapWithContext_WithDefaultValues(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"null":  nil,
+			"value": fakerInstance.Int(),
+		}
+
+		defaultValue = fakerInstance.Int()
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"null": {
+			vr.Default(defaultValue),
+			vr.Required(),
+		},
+		"missing": {
+			vr.DefaultFunc(func(_ context.Context, data any) any {
+				return data.(map[string]any)["value"]
+			}),
+			vr.Sometimes(),
+			vr.Present(),
+			vr.Required(),
+		},
+		"value": {
+			vr.Default(defaultValue),
+		},
+	}, ForMapWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.False(t, errorsBag.Any())
+
+	require.True(t, assertCollectorHasValue(t, collector, "null", defaultValue))
+	require.True(t, assertCollectorHasValue(t, collector, "missing", data["value"]))
+	require.True(t, assertCollectorHasValue(t, collector, "value", data["value"]))
+}
+
+func Test_ForMapWithContext_WithSanitizers(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"email": "  Foo@Example.COM ",
+			"name":  " <b>Foo</b>   Bar ",
+			"empty": "   ",
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"email": {
+			vs.TrimSpace(),
+			vs.ToLower(),
+			vr.In([]string{"foo@example.com"}),
+		},
+		"name": {
+			vs.StripTags(),
+			vs.CollapseWhitespace(),
+		},
+		"empty": {
+			vs.TrimSpace(),
+			vs.EmptyToNil(),
+			vr.Required(),
+		},
+	}, ForMapWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "empty"))
+
+	require.True(t, assertCollectorHasValue(t, collector, "email", "foo@example.com"))
+	require.True(t, assertCollectorHasValue(t, collector, "name", "Foo Bar"))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "empty"))
+}
+
+func Test_ForMapWithContext_WithNestedRules(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"customer": map[string]any{
+				"address": map[string]any{
+					"street": "Foo Street",
+					"city":   "",
+				},
+			},
+			"billing": map[string]any{
+				"street": "Bar Street",
+				"city":   "Bar City",
+			},
+		}
+
+		addressRules = RulesMap{
+			"street": {
+				vr.Required(),
+				vr.String(),
+			},
+			"city": {
+				vr.Required(),
+				vr.Filled(),
+			},
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"customer": {
+			vr.Required(),
+			vr.Nested(RulesMap{
+				"address": {
+					vr.Required(),
+					vr.Nested(addressRules),
+				},
+			}),
+		},
+		"billing": {
+			vr.Nested(addressRules),
+		},
+		"shipping": {
+			vr.Nested(addressRules),
+		},
+	}, ForMapWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewFilledValidationError()}, "customer.address.city"))
+
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "customer"))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "customer.address"))
+	require.True(t, assertCollectorHasValue(t, collector, "customer.address.street", "Foo Street"))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "customer.address.city"))
+	require.True(t, assertCollectorHasValue(t, collector, "billing", data["billing"]))
+	require.True(t, assertCollectorHasValue(t, collector, "billing.street", "Bar Street"))
+	require.True(t, assertCollectorHasValue(t, collector, "billing.city", "Bar City"))
+	require.True(t, assertCollectorHasValue(t, collector, "shipping", nil))
+}
+
+func Test_ForMapWithContext_WithEachRule(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"tags": []any{"foo", 123, nil},
+			"prices": map[string]any{
+				"foo": 1,
+				"bar": -1,
+			},
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"tags": {
+			vr.Slice(),
+			vr.Each(
+				vr.Required(),
+				vr.String(),
+			),
+		},
+		"prices": {
+			vr.Map(),
+			vr.Each(
+				vr.Min(0),
+			),
+		},
+	}, ForMapWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 3)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewStringValidationError()}, "tags.1"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "tags.2"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewMinValidationError(ve.TypeNumber, 0, true)}, "prices.bar"))
+
+	require.True(t, assertCollectorHasValue(t, collector, "tags.0", "foo"))
+	require.True(t, assertCollectorHasValue(t, collector, "prices.foo", 1))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "tags"))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "prices"))
+}
+
+func Test_ForMapWithContext_WithEachRuleOnMapWithPathLikeKeys(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"m": map[string]any{
+				"a.b": 1,
+				"*":   nil,
+			},
+		}
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"m": {
+			vr.Each(
+				vr.Required(),
+			),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "m.*"))
+}
+
+func Test_ForMapWithContext_WithTrace(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"name": " Foo ",
+			"age":  nil,
+		}
+		trace Trace
+	)
+
+	isCompany := func(_ context.Context, _ any, _ any) bool {
+		return false
+	}
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"name": {
+			vs.TrimSpace(),
+			vr.WhenFunc(isCompany, vr.Required()),
+		},
+		"age": {
+			vr.Bail(),
+			vr.Required(),
+			vr.Integer[int](),
+		},
+		"email": {
+			vr.Sometimes(),
+			vr.Required(),
+		},
+		"role": {
+			vr.Group([]string{"admin"}, vr.Required()),
+		},
+	}, ForMapWithTrace(&trace), ForMapWithGroups("user"))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.Len(t, trace.Fields, 4)
+
+	require.Equal(t, []*FieldTrace{{
+		Field: "name",
+		Rules: []RuleTrace{
+			{Rule: vs.RuleTrimSpace, Input: " Foo ", Output: "Foo"},
+			{Rule: ve.RuleWhen, Input: "Foo", Output: "Foo", Expanded: true, SubRules: []string{}},
+		},
+	}}, trace.Field("name"))
+
+	require.Equal(t, []*FieldTrace{{
+		Field: "age",
+		Rules: []RuleTrace{
+			{Rule: ve.RuleBail},
+			{Rule: ve.RuleRequired, Error: vr.NewRequiredValidationError(), Bailed: true},
+		},
+	}}, trace.Field("age"))
+
+	require.Equal(t, []*FieldTrace{{
+		Field:   "email",
+		Missing: true,
+		Rules: []RuleTrace{
+			{Rule: ve.RuleSometimes, Skipped: true},
+		},
+	}}, trace.Field("email"))
+
+	require.Equal(t, []*FieldTrace{{
+		Field:   "role",
+		Missing: true,
+		Rules: []RuleTrace{
+			{Rule: ve.RuleGroup, Expanded: true, SubRules: []string{}},
+		},
+	}}, trace.Field("role"))
+}
+
+func Test_ForMapWithContext_WithHooks(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"customer": map[string]any{
+				"name": nil,
+			},
+		}
+		hooks = &hooksSpy{}
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"customer": {
+			vr.Required(),
+			vr.Nested(map[string][]vr.Rule{
+				"name": {
+					vr.Required(),
+				},
+			}),
+		},
+	}, ForMapWithHooks(hooks))
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, errorsBag, hooks.errorsBag)
+	require.Equal(t, []string{
+		"start customer",
+		"applied customer REQUIRED: <nil>",
+		"applied customer NESTED: <nil>",
+		"start customer.name",
+		"applied customer.name REQUIRED: is required",
+		"done",
+	}, hooks.events)
+}
+
+func Test_ForMapWithContext_WithBeforeAndAfter(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"email": " foo@example.com ",
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"email": {
+			vr.Sometimes(),
+			vr.EmailAddress(),
+		},
+		"phone": {
+			vr.Sometimes(),
+			vr.String(),
+		},
+	},
+		ForMapWithDataCollector(collector),
+		ForMapWithBefore(func(_ context.Context, data map[string]any) (map[string]any, error) {
+			prepared := make(map[string]any, len(data))
+			for key, value := range data {
+				prepared[key] = strings.TrimSpace(value.(string))
+			}
+
+			return prepared, nil
+		}),
+		ForMapWithAfter(func(_ context.Context, data map[string]any, errorsBag ve.ErrorsBag) {
+			if _, hasPhone := data["phone"]; !hasPhone {
+				errorsBag.Add("phone", ve.NewCustomMessageValidationError("CONTACT", "email or phone is required"))
+			}
+		}),
+	)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{
+		ve.NewCustomMessageValidationError("CONTACT", "email or phone is required"),
+	}, "phone"))
+
+	require.True(t, assertCollectorHasValue(t, collector, "email", "foo@example.com"))
+	require.Equal(t, " foo@example.com ", data["email"])
+}
+
+func Test_ForMapWithContext_ReturnsErrorFromBeforeHook(t *testing.T) {
+	// given
+	var (
+		ctx         = context.TODO()
+		expectedErr = errors.New(fakerInstance.Lorem().Sentence(6))
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, map[string]any{}, RulesMap{}, ForMapWithBefore(func(_ context.Context, _ map[string]any) (map[string]any, error) {
+		return nil, expectedErr
+	}))
+
+	// then
+	require.ErrorIs(t, err, expectedErr)
+	require.Nil(t, errorsBag)
+}
+
+func Test_ForMapWithContext_WithObjectRules(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"total": 25,
+			"items": []any{
+				map[string]any{"amount": 10},
+				map[string]any{"amount": 20},
+			},
+		}
+	)
+
+	totalMatchesItems := ObjectRuleFunc(func(_ context.Context, data any) ve.ErrorsBag {
+		errorsBag := ve.NewErrorsBag()
+
+		sum := 0
+		for _, amount := range FieldValues(data, "items.*.amount") {
+			sum += amount.(int)
+		}
+
+		if total := FieldValues(data, "total")["total"]; total != sum {
+			errorsBag.Add("total", ve.NewCustomMessageValidationError("TOTAL", "must equal the sum of items"))
+			errorsBag.Add("items", ve.NewCustomMessageValidationError("TOTAL", "must sum up to total"))
+		}
+
+		return errorsBag
+	})
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"total": {
+			vr.Required(),
+			vr.Integer[int](),
+		},
+	}, ForMapWithObjectRules(totalMatchesItems))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 2)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{
+		ve.NewCustomMessageValidationError("TOTAL", "must equal the sum of items"),
+	}, "total"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{
+		ve.NewCustomMessageValidationError("TOTAL", "must sum up to total"),
+	}, "items"))
+}
+
+func Test_ForMapWithContext_WithMultipleErrorsRule(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"password": "abc",
+		}
+
+		errTooShort  = ve.NewCustomMessageValidationError("PASSWORD", "must be at least 8 characters long")
+		errNoDigit   = ve.NewCustomMessageValidationError("PASSWORD", "must contain a digit")
+		passwordRule = vr.Custom(func(_ context.Context, value string, _ any) (string, error) {
+			var errs []ve.ValidationError
+
+			if len(value) < 8 {
+				errs = append(errs, errTooShort)
+			}
+
+			if !strings.ContainsAny(value, "0123456789") {
+				errs = append(errs, errNoDigit)
+			}
+
+			return value, ve.Join(errs...)
+		})
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"password": {
+			passwordRule,
+			vr.Bail(),
+			vr.Missing(),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{
+		errTooShort,
+		errNoDigit,
+	}, "password"))
+}
+
+func Test_ForMapWithContext_WithWarnings(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"amount":   1500,
+			"nickname": "foo",
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"amount": {
+			vr.Integer[int](),
+			vr.Warn(vr.Max(1000)),
+			vr.Min(0),
+		},
+		"nickname": {
+			vr.Warn(vr.Missing()),
+		},
+	}, ForMapWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.False(t, errorsBag.Any())
+	require.Empty(t, errorsBag.Errors())
+	require.Equal(t, ve.ErrorsBag{
+		"amount":   {ve.NewSeverityValidationError(vr.NewMaxValidationError(ve.TypeNumber, 1000, true), ve.SeverityWarning)},
+		"nickname": {ve.NewSeverityValidationError(vr.NewMissingValidationError(), ve.SeverityWarning)},
+	}, errorsBag.Warnings())
+
+	require.True(t, assertCollectorHasValue(t, collector, "amount", 1500))
+	require.True(t, assertCollectorHasValue(t, collector, "nickname", "foo"))
+}
+
+func Test_ForMapWithContext_WithPartialValidationInsideCombinators(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"user": map[string]any{"email": ""},
+		}
+	)
+
+	// when
+	errorsBag, err := ForMapWithContext(ctx, data, RulesMap{
+		"user": {
+			vr.AllOf([]vr.Rule{
+				vr.Nested(RulesMap{
+					"name": {
+						vr.Required(),
+					},
+					"email": {
+						vr.Filled(),
+					},
+				}),
+			}),
+		},
+	}, ForMapWithPartialValidation())
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{
+		vr.NewAllOfValidationError([][]ve.ValidationError{
+			{ve.NewFieldValidationError("email", vr.NewFilledValidationError())},
+		}),
+	}, "user"))
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"reflect"
+	"time"
 
 	ve "github.com/donatorsky/go-validator/error"
 	vr "github.com/donatorsky/go-validator/rule"
@@ -29,14 +30,40 @@ func ForSliceWithContext(ctx context.Context, data any, rules []vr.Rule, options
 		}
 	}
 
+	if opts.groups != nil {
+		ctx = vr.ContextWithGroups(ctx, opts.groups...)
+	}
+
+	if len(opts.before) > 0 {
+		preparedData, err := opts.applyBefore(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+
+		data, _ = vr.Dereference(preparedData)
+		if data == nil {
+			return nil, ve.NotListTypeError{}
+		}
+
+		if kind := reflect.TypeOf(data).Kind(); kind != reflect.Slice && kind != reflect.Array {
+			return nil, ve.NotListTypeError{}
+		}
+	}
+
 	errorsBag := ve.NewErrorsBag()
 
+	defer opts.validationDone(ctx, errorsBag, time.Now())
+
 	for fieldValue := range newFieldsIterator("*", data) {
 		if err := applyRules(ctx, data, rules, fieldValue, errorsBag, opts); err != nil {
 			return nil, err
 		}
 	}
 
+	applyObjectRules(ctx, data, opts.objectRules, errorsBag)
+
+	opts.applyAfter(ctx, data, errorsBag)
+
 	return errorsBag, nil
 }
 
@@ -47,3 +74,51 @@ func ForSliceWithDataCollector(collector DataCollector) forSliceValidatorOption
 		return nil
 	}
 }
+
+func ForSliceWithGroups(groups ...string) forSliceValidatorOption {
+	return func(options *validatorOptions) error {
+		options.groups = groups
+
+		return nil
+	}
+}
+
+func ForSliceWithTrace(trace *Trace) forSliceValidatorOption {
+	return func(options *validatorOptions) error {
+		options.trace = trace
+
+		return nil
+	}
+}
+
+func ForSliceWithHooks(hooks Hooks) forSliceValidatorOption {
+	return func(options *validatorOptions) error {
+		options.hooks = hooks
+
+		return nil
+	}
+}
+
+func ForSliceWithBefore(hook func(ctx context.Context, data any) (any, error)) forSliceValidatorOption {
+	return func(options *validatorOptions) error {
+		options.before = append(options.before, hook)
+
+		return nil
+	}
+}
+
+func ForSliceWithAfter(hook func(ctx context.Context, data any, errorsBag ve.ErrorsBag)) forSliceValidatorOption {
+	return func(options *validatorOptions) error {
+		options.after = append(options.after, hook)
+
+		return nil
+	}
+}
+
+func ForSliceWithObjectRules(rules ...ObjectRule) forSliceValidatorOption {
+	return func(options *validatorOptions) error {
+		options.objectRules = append(options.objectRules, rules...)
+
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -160,3 +161,22 @@ func Test_ForSliceWithContext_WithDataCollector(t *testing.T) {
 		require.True(t, assertCollectorHasValue(t, collector, "2", newValue))
 	})
 }
+
+func Test_ForSliceWithContext_FailsWhenBeforeHookReturnsInvalidData(t *testing.T) {
+	for ttIdx, preparedData := range []any{
+		map[string]any{},
+		nil,
+		(*[]int)(nil),
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			errorsBag, err := ForSliceWithContext(context.TODO(), []int{}, []vr.Rule{}, ForSliceWithBefore(func(_ context.Context, _ any) (any, error) {
+				return preparedData, nil
+			}))
+
+			// then
+			require.ErrorIs(t, err, ve.NotListTypeError{})
+			require.Nil(t, errorsBag)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"reflect"
+	"time"
 
 	ve "github.com/donatorsky/go-validator/error"
 	vr "github.com/donatorsky/go-validator/rule"
@@ -15,6 +16,7 @@ func ForStruct(data any, rules RulesMap, options ...forStructValidatorOption) (v
 }
 
 func ForStructWithContext(ctx context.Context, data any, rules RulesMap, options ...forStructValidatorOption) (ve.ErrorsBag, error) {
+	originalData := data
 	data, _ = vr.Dereference(data)
 
 	if reflect.TypeOf(data).Kind() != reflect.Struct {
@@ -29,16 +31,48 @@ func ForStructWithContext(ctx context.Context, data any, rules RulesMap, options
 		}
 	}
 
+	if opts.groups != nil {
+		ctx = vr.ContextWithGroups(ctx, opts.groups...)
+	}
+
+	if len(opts.before) > 0 {
+		preparedData, err := opts.applyBefore(ctx, originalData)
+		if err != nil {
+			return nil, err
+		}
+
+		originalData = preparedData
+		data, _ = vr.Dereference(originalData)
+
+		if data == nil || reflect.TypeOf(data).Kind() != reflect.Struct {
+			return nil, ve.NotStructTypeError{}
+		}
+	}
+
 	errorsBag := ve.NewErrorsBag()
 
+	defer opts.validationDone(ctx, errorsBag, time.Now())
+
 	for field, rules := range rules {
 		for fieldValue := range newFieldsIterator(field, data) {
+			if opts.partial && fieldValue.missing {
+				continue
+			}
+
 			if err := applyRules(ctx, data, rules, fieldValue, errorsBag, opts); err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	if _, err := applySelfValidation(ctx, originalData, "", errorsBag, opts); err != nil {
+		return nil, err
+	}
+
+	applyObjectRules(ctx, originalData, opts.objectRules, errorsBag)
+
+	opts.applyAfter(ctx, originalData, errorsBag)
+
 	return errorsBag, nil
 }
 
@@ -49,3 +83,59 @@ func ForStructWithDataCollector(collector DataCollector) forStructValidatorOptio
 		return nil
 	}
 }
+
+func ForStructWithPartialValidation() forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.partial = true
+
+		return nil
+	}
+}
+
+func ForStructWithGroups(groups ...string) forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.groups = groups
+
+		return nil
+	}
+}
+
+func ForStructWithTrace(trace *Trace) forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.trace = trace
+
+		return nil
+	}
+}
+
+func ForStructWithHooks(hooks Hooks) forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.hooks = hooks
+
+		return nil
+	}
+}
+
+func ForStructWithBefore(hook func(ctx context.Context, data any) (any, error)) forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.before = append(options.before, hook)
+
+		return nil
+	}
+}
+
+func ForStructWithAfter(hook func(ctx context.Context, data any, errorsBag ve.ErrorsBag)) forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.after = append(options.after, hook)
+
+		return nil
+	}
+}
+
+func ForStructWithObjectRules(rules ...ObjectRule) forStructValidatorOption {
+	return func(options *validatorOptions) error {
+		options.objectRules = append(options.objectRules, rules...)
+
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -271,3 +272,131 @@ func Test_ForStructWithContext_WithDataCollector(t *testing.T) {
 		require.True(t, assertCollectorHasValue(t, collector, "slice.2", data.Slice[2]))
 	})
 }
+
+func Test_ForStructWithContext_WithPartialValidation(t *testing.T) {
+	// given
+	type someInnerRequest struct {
+		Value *int
+	}
+
+	type somePartialRequest struct {
+		Value *int
+		Inner *someInnerRequest
+	}
+
+	var ctx = context.TODO()
+
+	// when
+	errorsBag, err := ForStructWithContext(ctx, somePartialRequest{}, RulesMap{
+		"Value": {
+			vr.Required(),
+		},
+		"NonExistingValue": {
+			vr.Required(),
+		},
+		"Inner.Value": {
+			vr.Required(),
+		},
+	}, ForStructWithPartialValidation())
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewRequiredValidationError()}, "Value"))
+}
+
+func Test_ForStructWithContext_WithGroups(t *testing.T) {
+	// given
+	type someGroupedRequest struct {
+		Value      *int
+		AdminValue *int `validationGroups:"admin"`
+	}
+
+	var ctx = context.TODO()
+
+	rules := RulesMap{
+		"Value": {
+			vr.Required(),
+		},
+		"AdminValue": {
+			vr.Required(),
+		},
+	}
+
+	t.Run("fields outside of active groups are skipped", func(t *testing.T) {
+		// when
+		errorsBag, err := ForStructWithContext(ctx, someGroupedRequest{}, rules, ForStructWithGroups("create"))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, errorsBag, 1)
+		require.True(t, errorsBag.Has("Value"))
+	})
+
+	t.Run("fields in active groups are validated", func(t *testing.T) {
+		// when
+		errorsBag, err := ForStructWithContext(ctx, someGroupedRequest{}, rules, ForStructWithGroups("create", "admin"))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, errorsBag, 2)
+		require.True(t, errorsBag.Has("Value"))
+		require.True(t, errorsBag.Has("AdminValue"))
+	})
+}
+
+func Test_ForStructWithContext_WithBeforeAndAfter(t *testing.T) {
+	// given
+	type period struct {
+		From int
+		To   int
+	}
+
+	ctx := context.TODO()
+
+	// when
+	errorsBag, err := ForStructWithContext(ctx, period{From: 5, To: 3}, RulesMap{
+		"From": {
+			vr.Min(0),
+		},
+	},
+		ForStructWithBefore(func(_ context.Context, data any) (any, error) {
+			p := data.(period)
+			p.From = -p.From
+
+			return &p, nil
+		}),
+		ForStructWithAfter(func(_ context.Context, data any, errorsBag ve.ErrorsBag) {
+			if p := data.(*period); p.From < 0 {
+				errorsBag.Add("From", ve.NewCustomMessageValidationError("PERIOD", "must not be negative"))
+			}
+		}),
+	)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 1)
+	require.Len(t, errorsBag.Get("From"), 2)
+	require.Equal(t, ve.RuleMin, errorsBag.Get("From")[0].GetRule())
+	require.Equal(t, ve.NewCustomMessageValidationError("PERIOD", "must not be negative"), errorsBag.Get("From")[1])
+}
+
+func Test_ForStructWithContext_FailsWhenBeforeHookReturnsInvalidData(t *testing.T) {
+	for ttIdx, preparedData := range []any{
+		[]int{},
+		nil,
+		(*struct{})(nil),
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			errorsBag, err := ForStructWithContext(context.TODO(), struct{}{}, RulesMap{}, ForStructWithBefore(func(_ context.Context, _ any) (any, error) {
+				return preparedData, nil
+			}))
+
+			// then
+			require.ErrorIs(t, err, ve.NotStructTypeError{})
+			require.Nil(t, errorsBag)
+		})
+	}
+}
@@ -3,6 +3,9 @@ package validator
 import (
 	"context"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	ve "github.com/donatorsky/go-validator/error"
 	vr "github.com/donatorsky/go-validator/rule"
@@ -23,8 +26,14 @@ func ForValueWithContext[In any](ctx context.Context, value In, rules []vr.Rule,
 		}
 	}
 
+	if opts.groups != nil {
+		ctx = vr.ContextWithGroups(ctx, opts.groups...)
+	}
+
 	errorsBag := ve.NewErrorsBag()
 
+	defer opts.validationDone(ctx, errorsBag, time.Now())
+
 	if err := applyRules(
 		ctx,
 		value,
@@ -39,7 +48,7 @@ func ForValueWithContext[In any](ctx context.Context, value In, rules []vr.Rule,
 		return nil, err
 	}
 
-	return errorsBag.Get("_"), nil
+	return flattenValueErrors(errorsBag), nil
 }
 
 func ForValueWithValueExporter[Out any](value *Out) forValueValidatorOption {
@@ -50,3 +59,48 @@ func ForValueWithValueExporter[Out any](value *Out) forValueValidatorOption {
 		return nil
 	}
 }
+
+func ForValueWithGroups(groups ...string) forValueValidatorOption {
+	return func(options *validatorOptions) error {
+		options.groups = groups
+
+		return nil
+	}
+}
+
+func ForValueWithTrace(trace *Trace) forValueValidatorOption {
+	return func(options *validatorOptions) error {
+		options.trace = trace
+
+		return nil
+	}
+}
+
+func ForValueWithHooks(hooks Hooks) forValueValidatorOption {
+	return func(options *validatorOptions) error {
+		options.hooks = hooks
+
+		return nil
+	}
+}
+
+func flattenValueErrors(errorsBag ve.ErrorsBag) []ve.ValidationError {
+	errors := errorsBag.Get("_")
+
+	nestedFields := make([]string, 0, len(errorsBag))
+	for field := range errorsBag {
+		if strings.HasPrefix(field, "_.") {
+			nestedFields = append(nestedFields, field)
+		}
+	}
+
+	sort.Strings(nestedFields)
+
+	for _, field := range nestedFields {
+		for _, validationError := range errorsBag.Get(field) {
+			errors = append(errors, ve.NewFieldValidationError(strings.TrimPrefix(field, "_."), validationError))
+		}
+	}
+
+	return errors
+}
@@ -145,4 +145,129 @@ func Test_ForValueWithContext_WithValueExporter(t *testing.T) {
 		require.Empty(t, validationErrors)
 		require.Equal(t, newValue, out)
 	})
+	t.Run("default value is assigned", func(t *testing.T) {
+		var (
+			defaultValue = fakerInstance.Int()
+			out          int
+		)
+
+		// when
+		validationErrors, err := ForValueWithContext(
+			ctx,
+			(*int)(nil),
+			[]vr.Rule{
+				vr.Default(defaultValue),
+				vr.Required(),
+			},
+			ForValueWithValueExporter(&out),
+		)
+
+		// then
+		require.NoError(t, err)
+		require.Empty(t, validationErrors)
+		require.Equal(t, defaultValue, out)
+	})
+
+	t.Run("zero value is assigned when nil value is valid", func(t *testing.T) {
+		var out any = fakerInstance.Int()
+
+		// when
+		validationErrors, err := ForValueWithContext[any](
+			ctx,
+			nil,
+			[]vr.Rule{
+				vr.Nullable(),
+				vr.Integer[int](),
+			},
+			ForValueWithValueExporter(&out),
+		)
+
+		// then
+		require.NoError(t, err)
+		require.Empty(t, validationErrors)
+		require.Nil(t, out)
+	})
+}
+
+func Test_ForValueWithContext_WithNestedRules(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = map[string]any{
+			"foo": nil,
+			"bar": "",
+		}
+	)
+
+	// when
+	validationErrors, err := ForValueWithContext(
+		ctx,
+		data,
+		[]vr.Rule{
+			vr.Required(),
+			vr.Custom(func(_ context.Context, _ any, _ any) (any, error) {
+				return nil, errors.New("validation failed")
+			}),
+			vr.Nested(RulesMap{
+				"foo": {
+					vr.Required(),
+				},
+				"bar": {
+					vr.Filled(),
+				},
+			}),
+		},
+	)
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []ve.ValidationError{
+		vr.NewCustomValidationError(errors.New("validation failed")),
+		ve.NewFieldValidationError("bar", vr.NewFilledValidationError()),
+		ve.NewFieldValidationError("foo", vr.NewRequiredValidationError()),
+	}, validationErrors)
+}
+
+func Test_ForValueWithContext_WithEachRule(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = []int{1, -2, 3, -4}
+	)
+
+	// when
+	validationErrors, err := ForValueWithContext(
+		ctx,
+		data,
+		[]vr.Rule{
+			vr.Required(),
+			vr.Each(
+				vr.Min(0),
+			),
+		},
+	)
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []ve.ValidationError{
+		ve.NewFieldValidationError("1", vr.NewMinValidationError(ve.TypeNumber, 0, true)),
+		ve.NewFieldValidationError("3", vr.NewMinValidationError(ve.TypeNumber, 0, true)),
+	}, validationErrors)
+}
+
+func Test_ForValueWithContext_KeepsSeverityOfNestedErrors(t *testing.T) {
+	// given
+	var ctx = context.TODO()
+
+	// when
+	validationErrors, err := ForValueWithContext(ctx, []string{"ab"}, []vr.Rule{
+		vr.Each(
+			vr.Warn(vr.Min(3)),
+		),
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, validationErrors, 1)
+	require.Equal(t, ve.SeverityWarning, ve.SeverityOf(validationErrors[0]))
 }
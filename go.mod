@@ -6,10 +6,11 @@ require (
 	github.com/golang/mock v1.6.0
 	github.com/jaswdr/faker v1.19.1
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
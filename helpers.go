@@ -3,45 +3,89 @@ package validator
 import (
 	"context"
 	"reflect"
+	"strings"
+	"time"
 
 	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/internal/engine"
 	vr "github.com/donatorsky/go-validator/rule"
 )
 
 type RulesMap map[string][]vr.Rule
 
 func applyRules(ctx context.Context, data any, rules []vr.Rule, fieldValue fieldValue, errorsBag ve.ErrorsBag, options *validatorOptions) error {
-	anyRuleFailed := false
-	i := newRecursiveIterator(rules, ctx, fieldValue.value, data)
+	var fieldTrace *FieldTrace
+	if options.trace != nil {
+		fieldTrace = options.trace.startField(fieldValue)
+	}
 
-	value := fieldValue.value
+	if !options.inActiveGroups(fieldValue.groups) {
+		if fieldTrace != nil {
+			fieldTrace.InactiveGroups = true
+		}
 
-	for i.Valid() {
-		rule := i.Current()
+		return nil
+	}
 
-		var err ve.ValidationError
+	if options.hooks != nil {
+		options.hooks.OnFieldStart(ctx, fieldValue.field)
+	}
 
-		if value, err = rule.Apply(ctx, value, data); err != nil {
-			errorsBag.Add(fieldValue.field, err)
+	runner := engine.Runner[vr.Rule]{
+		Nested: func(ctx context.Context, value any, targets []engine.Target[vr.Rule]) (ve.ErrorsBag, error) {
+			return applyNestedRules(ctx, value, targets, fieldValue.field, options)
+		},
+		Combinator: subRulesNestedFunc(fieldValue.field, options),
+	}
 
-			anyRuleFailed = true
+	if options.hooks != nil || fieldTrace != nil {
+		runner.Listener = &fieldListener{
+			ctx:   ctx,
+			field: fieldValue.field,
+			hooks: options.hooks,
+			trace: fieldTrace,
 		}
+	}
 
-		if bailingRule, ok := rule.(vr.BailingRule); ok && anyRuleFailed && bailingRule.Bails() {
-			break
-		}
+	result, err := runner.Run(ctx, fieldValue.value, !fieldValue.missing, data, rules)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Errors) > 0 {
+		errorsBag.Add(fieldValue.field, result.Errors...)
+	}
+
+	for field, errors := range result.Nested {
+		errorsBag.Add(field, errors...)
+	}
+
+	if result.Failed || (result.Skipped && result.Missing) {
+		return nil
+	}
+
+	value := result.Value
 
-		i.Next(ctx, value, data)
+	if selfValidationFailed, err := applySelfValidation(ctx, value, fieldValue.field, errorsBag, options); err != nil {
+		return err
+	} else if selfValidationFailed {
+		return nil
 	}
 
-	if !anyRuleFailed && options.dataCollector != nil {
+	if options.dataCollector != nil {
 		options.dataCollector.Set(fieldValue.field, value)
 	}
 
-	if !anyRuleFailed && options.valueExporter != nil {
+	if options.valueExporter != nil {
 		targetValue := options.valueExporter.Elem()
 		targetType := targetValue.Type()
 
+		if value == nil {
+			targetValue.Set(reflect.Zero(targetType))
+
+			return nil
+		}
+
 		if valueType := reflect.TypeOf(value); !valueType.ConvertibleTo(targetType) {
 			return ve.ValueExporterTypeMismatchError{
 				ValueType:  valueType.String(),
@@ -54,3 +98,84 @@ func applyRules(ctx context.Context, data any, rules []vr.Rule, fieldValue field
 
 	return nil
 }
+
+func applyNestedRules(ctx context.Context, data any, targets []engine.Target[vr.Rule], parentField string, options *validatorOptions) (ve.ErrorsBag, error) {
+	nestedErrorsBag := ve.NewErrorsBag()
+
+	nestedOptions := *options
+	nestedOptions.valueExporter = nil
+
+	for _, target := range targets {
+		if options.partial && target.Missing {
+			continue
+		}
+
+		fieldValue := fieldValue{
+			field:   joinFields(parentField, target.Field),
+			value:   target.Value,
+			missing: target.Missing,
+			groups:  target.Groups,
+		}
+
+		if err := applyRules(ctx, data, target.Rules, fieldValue, nestedErrorsBag, &nestedOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	return nestedErrorsBag, nil
+}
+
+func subRulesNestedFunc(parentField string, options *validatorOptions) engine.NestedFunc[vr.Rule] {
+	nestedOptions := *options
+	nestedOptions.dataCollector = nil
+
+	return func(ctx context.Context, data any, targets []engine.Target[vr.Rule]) (ve.ErrorsBag, error) {
+		nestedErrorsBag, err := applyNestedRules(ctx, data, targets, parentField, &nestedOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		relativeErrorsBag := ve.NewErrorsBag()
+		for field, errors := range nestedErrorsBag {
+			relativeErrorsBag.Add(strings.TrimPrefix(field, joinFields(parentField, "")), errors...)
+		}
+
+		return relativeErrorsBag, nil
+	}
+}
+
+type fieldListener struct {
+	ctx       context.Context
+	field     string
+	hooks     Hooks
+	trace     *FieldTrace
+	lastTrace *RuleTrace
+}
+
+func (l *fieldListener) RulesExpanded(rule vr.Rule, subRules []vr.Rule, value any) {
+	if l.trace != nil {
+		l.trace.rulesExpanded(rule, subRules, value)
+	}
+}
+
+func (l *fieldListener) RuleApplied(rule vr.Rule, input, output any, err ve.ValidationError, duration time.Duration) {
+	if l.hooks != nil {
+		l.hooks.OnRuleApplied(l.ctx, l.field, vr.Describe(rule).Name, duration, err)
+	}
+
+	if l.trace != nil {
+		l.lastTrace = l.trace.ruleApplied(rule, input, output, err)
+	}
+}
+
+func (l *fieldListener) RulesStopped(bailed bool) {
+	if l.lastTrace == nil {
+		return
+	}
+
+	if bailed {
+		l.lastTrace.Bailed = true
+	} else {
+		l.lastTrace.Skipped = true
+	}
+}
@@ -0,0 +1,26 @@
+package validator
+
+import (
+	"context"
+	"time"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type Hooks interface {
+	OnFieldStart(ctx context.Context, field string)
+	OnRuleApplied(ctx context.Context, field string, rule string, duration time.Duration, err ve.ValidationError)
+	OnValidationDone(ctx context.Context, errorsBag ve.ErrorsBag, duration time.Duration)
+}
+
+type NopHooks struct {
+}
+
+func (NopHooks) OnFieldStart(_ context.Context, _ string) {
+}
+
+func (NopHooks) OnRuleApplied(_ context.Context, _ string, _ string, _ time.Duration, _ ve.ValidationError) {
+}
+
+func (NopHooks) OnValidationDone(_ context.Context, _ ve.ErrorsBag, _ time.Duration) {
+}
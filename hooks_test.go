@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type hooksSpy struct {
+	NopHooks
+
+	events    []string
+	errorsBag ve.ErrorsBag
+}
+
+func (h *hooksSpy) OnFieldStart(_ context.Context, field string) {
+	h.events = append(h.events, "start "+field)
+}
+
+func (h *hooksSpy) OnRuleApplied(_ context.Context, field string, rule string, _ time.Duration, err ve.ValidationError) {
+	h.events = append(h.events, fmt.Sprintf("applied %s %s: %v", field, rule, err))
+}
+
+func (h *hooksSpy) OnValidationDone(_ context.Context, errorsBag ve.ErrorsBag, _ time.Duration) {
+	h.events = append(h.events, "done")
+	h.errorsBag = errorsBag
+}
+
+func Test_NopHooks(t *testing.T) {
+	// given
+	var hooks Hooks = NopHooks{}
+
+	// then
+	require.NotPanics(t, func() {
+		hooks.OnFieldStart(context.TODO(), "field")
+		hooks.OnRuleApplied(context.TODO(), "field", "RULE", time.Second, nil)
+		hooks.OnValidationDone(context.TODO(), ve.NewErrorsBag(), time.Second)
+	})
+}
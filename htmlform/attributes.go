@@ -0,0 +1,288 @@
+package htmlform
+
+import (
+	"html"
+	"html/template"
+	"math"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+const (
+	inputTypeDate   = "date"
+	inputTypeEmail  = "email"
+	inputTypeNumber = "number"
+	inputTypeURL    = "url"
+)
+
+type Attribute struct {
+	Name  string
+	Value string
+	Bool  bool
+}
+
+type Attributes []Attribute
+
+func (a Attributes) Get(name string) (string, bool) {
+	for _, attribute := range a {
+		if attribute.Name == name {
+			return attribute.Value, true
+		}
+	}
+
+	return "", false
+}
+
+func (a Attributes) String() string {
+	parts := make([]string, len(a))
+
+	for idx, attribute := range a {
+		if attribute.Bool {
+			parts[idx] = attribute.Name
+		} else {
+			parts[idx] = attribute.Name + `="` + html.EscapeString(attribute.Value) + `"`
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (a Attributes) HTMLAttr() template.HTMLAttr {
+	return template.HTMLAttr(a.String())
+}
+
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"validationAttrs": func(rules validator.RulesMap, field string) template.HTMLAttr {
+			return For(rules, field).HTMLAttr()
+		},
+	}
+}
+
+func For(rules validator.RulesMap, field string) Attributes {
+	var descriptors []vr.RuleDescriptor
+
+	for _, pattern := range matchingFields(rules, field) {
+		for _, rule := range rules[pattern] {
+			if describableRule, ok := rule.(vr.DescribableRule); ok {
+				descriptors = append(descriptors, describableRule.Describe())
+			}
+		}
+	}
+
+	var (
+		inputType                                       string
+		integer, required                               bool
+		minimum, maximum, minLength, maxLength, pattern *string
+	)
+
+	for _, descriptor := range descriptors {
+		switch descriptor.Name {
+		case ve.RuleInt:
+			inputType, integer = inputTypeNumber, true
+
+		case ve.RuleFloat, ve.RuleNumeric:
+			inputType, integer = inputTypeNumber, false
+
+		case ve.RuleEmail:
+			inputType = inputTypeEmail
+
+		case ve.RuleURL:
+			inputType = inputTypeURL
+
+		case ve.RuleDateFormat:
+			if descriptor.Params["format"] == "2006-01-02" {
+				inputType = inputTypeDate
+			}
+		}
+	}
+
+	isNumber := inputType == inputTypeNumber
+
+	for _, descriptor := range descriptors {
+		inclusive := descriptor.Params["inclusive"] == true
+
+		switch descriptor.Name {
+		case ve.RuleRequired:
+			required = true
+
+		case ve.RuleMin:
+			if isNumber {
+				minimum = numberBound(descriptor.Params["threshold"], inclusive, integer, true)
+			} else {
+				minLength = lengthBound(descriptor.Params["threshold"], inclusive, true)
+			}
+
+		case ve.RuleMax:
+			if isNumber {
+				maximum = numberBound(descriptor.Params["threshold"], inclusive, integer, false)
+			} else {
+				maxLength = lengthBound(descriptor.Params["threshold"], inclusive, false)
+			}
+
+		case ve.RuleBetween:
+			if isNumber {
+				minimum = numberBound(descriptor.Params["min"], inclusive, integer, true)
+				maximum = numberBound(descriptor.Params["max"], inclusive, integer, false)
+			} else {
+				minLength = lengthBound(descriptor.Params["min"], inclusive, true)
+				maxLength = lengthBound(descriptor.Params["max"], inclusive, false)
+			}
+
+		case ve.RuleLength:
+			if !isNumber {
+				minLength = lengthBound(descriptor.Params["length"], true, true)
+				maxLength = lengthBound(descriptor.Params["length"], true, false)
+			}
+
+		case ve.RuleRegex:
+			if regex, ok := descriptor.Params["pattern"].(string); ok {
+				pattern = browserPattern(regex)
+			}
+		}
+	}
+
+	var attributes Attributes
+
+	if inputType != "" {
+		attributes = append(attributes, Attribute{Name: "type", Value: inputType})
+	}
+
+	if required {
+		attributes = append(attributes, Attribute{Name: "required", Bool: true})
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value *string
+	}{
+		{name: "min", value: minimum},
+		{name: "max", value: maximum},
+		{name: "minlength", value: minLength},
+		{name: "maxlength", value: maxLength},
+		{name: "pattern", value: pattern},
+	} {
+		if attribute.value != nil {
+			attributes = append(attributes, Attribute{Name: attribute.name, Value: *attribute.value})
+		}
+	}
+
+	return attributes
+}
+
+func matchingFields(rules validator.RulesMap, field string) []string {
+	fieldParts := strings.Split(field, ".")
+
+	var fields []string
+
+	for pattern := range rules {
+		patternParts := strings.Split(pattern, ".")
+		if len(patternParts) != len(fieldParts) {
+			continue
+		}
+
+		matches := true
+
+		for idx, part := range patternParts {
+			if part != "*" && part != fieldParts[idx] {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			fields = append(fields, pattern)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+func numberBound(value any, inclusive, integer, lower bool) *string {
+	threshold, _ := vr.ToFloat(value)
+
+	if !inclusive {
+		if !integer || threshold != math.Trunc(threshold) {
+			return nil
+		}
+
+		if lower {
+			threshold++
+		} else {
+			threshold--
+		}
+	}
+
+	formatted := strconv.FormatFloat(threshold, 'f', -1, 64)
+
+	return &formatted
+}
+
+func browserPattern(pattern string) *string {
+	for idx := 0; idx < len(pattern); idx++ {
+		switch {
+		case pattern[idx] == '\\' && idx+1 < len(pattern):
+			if strings.IndexByte("AzQE", pattern[idx+1]) >= 0 {
+				return nil
+			}
+
+			idx++
+
+		case strings.HasPrefix(pattern[idx:], "(?"):
+			if !strings.HasPrefix(pattern[idx:], "(?:") && !strings.HasPrefix(pattern[idx:], "(?<") {
+				return nil
+			}
+
+		case strings.HasPrefix(pattern[idx:], "[[:"):
+			return nil
+		}
+	}
+
+	regex, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+
+	if regex.Op == syntax.OpConcat &&
+		regex.Sub[0].Op == syntax.OpBeginText &&
+		regex.Sub[len(regex.Sub)-1].Op == syntax.OpEndText {
+		return &pattern
+	}
+
+	wrapped := ".*(?:" + pattern + ").*"
+
+	return &wrapped
+}
+
+func lengthBound(value any, inclusive, lower bool) *string {
+	threshold, _ := vr.ToFloat(value)
+
+	var count float64
+
+	switch {
+	case lower && inclusive:
+		count = math.Ceil(threshold)
+
+	case lower:
+		count = math.Floor(threshold) + 1
+
+	case inclusive:
+		count = math.Floor(threshold)
+
+	default:
+		count = math.Ceil(threshold) - 1
+	}
+
+	formatted := strconv.Itoa(int(math.Max(count, 0)))
+
+	return &formatted
+}
@@ -0,0 +1,186 @@
+package htmlform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_For(t *testing.T) {
+	// given
+	rules := validator.RulesMap{
+		"name": {
+			vr.Required(),
+			vr.String(),
+			vr.Between(3, 64),
+			vr.Regex(regexp.MustCompile(`^[a-z"]+$`)),
+		},
+		"email": {
+			vr.Required(),
+			vr.EmailAddress(),
+		},
+		"website": {
+			vr.URL(),
+			vr.Max(255),
+		},
+		"birthday": {
+			vr.DateFormat("2006-01-02"),
+		},
+		"created_at": {
+			vr.Date(),
+		},
+		"age": {
+			vr.Integer[int](),
+			vr.MinExclusive(17),
+			vr.Max(130),
+		},
+		"price": {
+			vr.Float[float64](),
+			vr.BetweenExclusive(0, 99.99),
+		},
+		"amount": {
+			vr.Numeric(),
+			vr.Min(0.5),
+		},
+		"code": {
+			vr.Length(4),
+			vr.MaxExclusive(5),
+		},
+		"items.*.quantity": {
+			vr.Integer[int](),
+			vr.Min(1),
+		},
+		"items.0.quantity": {
+			vr.Required(),
+		},
+		"custom": {
+			vr.Custom(func(_ context.Context, value string, _ any) (string, error) {
+				return value, nil
+			}),
+		},
+	}
+
+	for ttIdx, tt := range []struct {
+		field              string
+		expectedAttributes string
+	}{
+		{field: "name", expectedAttributes: `required minlength="3" maxlength="64" pattern="^[a-z&#34;]+$"`},
+		{field: "email", expectedAttributes: `type="email" required`},
+		{field: "website", expectedAttributes: `type="url" maxlength="255"`},
+		{field: "birthday", expectedAttributes: `type="date"`},
+		{field: "created_at", expectedAttributes: ``},
+		{field: "age", expectedAttributes: `type="number" min="18" max="130"`},
+		{field: "price", expectedAttributes: `type="number"`},
+		{field: "amount", expectedAttributes: `type="number" min="0.5"`},
+		{field: "code", expectedAttributes: `minlength="4" maxlength="4"`},
+		{field: "items.0.quantity", expectedAttributes: `type="number" required min="1"`},
+		{field: "items.1.quantity", expectedAttributes: `type="number" min="1"`},
+		{field: "items", expectedAttributes: ``},
+		{field: "custom", expectedAttributes: ``},
+		{field: "unknown", expectedAttributes: ``},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			attributes := For(rules, tt.field)
+
+			// then
+			require.Equal(t, tt.expectedAttributes, attributes.String())
+			require.Equal(t, template.HTMLAttr(tt.expectedAttributes), attributes.HTMLAttr())
+		})
+	}
+}
+
+func Test_For_Pattern(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		pattern         string
+		expectedPattern string
+		expectedFound   bool
+	}{
+		{pattern: `^[a-z]+$`, expectedPattern: `^[a-z]+$`, expectedFound: true},
+		{pattern: `\d`, expectedPattern: `.*(?:\d).*`, expectedFound: true},
+		{pattern: `^\d+`, expectedPattern: `.*(?:^\d+).*`, expectedFound: true},
+		{pattern: `^a|b$`, expectedPattern: `.*(?:^a|b$).*`, expectedFound: true},
+		{pattern: `(?:ab)+`, expectedPattern: `.*(?:(?:ab)+).*`, expectedFound: true},
+		{pattern: `(?<name>a)`, expectedPattern: `.*(?:(?<name>a)).*`, expectedFound: true},
+		{pattern: `a\\z`, expectedPattern: `.*(?:a\\z).*`, expectedFound: true},
+		{pattern: `(?P<name>a)`},
+		{pattern: `(?i)abc`},
+		{pattern: `^a(?s:.)b$`},
+		{pattern: `\Aabc`},
+		{pattern: `abc\z`},
+		{pattern: `\Qa.b\E`},
+		{pattern: `[[:alpha:]]`},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// given
+			rules := validator.RulesMap{
+				"name": {
+					vr.Regex(regexp.MustCompile(tt.pattern)),
+				},
+			}
+
+			// when
+			pattern, found := For(rules, "name").Get("pattern")
+
+			// then
+			require.Equal(t, tt.expectedFound, found)
+			require.Equal(t, tt.expectedPattern, pattern)
+		})
+	}
+}
+
+func Test_Attributes_Get(t *testing.T) {
+	// given
+	attributes := For(validator.RulesMap{
+		"name": {
+			vr.Required(),
+			vr.Max(10),
+		},
+	}, "name")
+
+	// when
+	maxLength, maxLengthFound := attributes.Get("maxlength")
+	_, patternFound := attributes.Get("pattern")
+
+	// then
+	require.True(t, maxLengthFound)
+	require.Equal(t, "10", maxLength)
+	require.False(t, patternFound)
+}
+
+func Test_FuncMap(t *testing.T) {
+	// given
+	tmpl := template.Must(template.New("form").Funcs(FuncMap()).Parse(
+		`<input name="name" {{ validationAttrs .Rules "name" }}><input {{ .Attributes }}>`,
+	))
+
+	rules := validator.RulesMap{
+		"name": {
+			vr.Required(),
+			vr.Regex(regexp.MustCompile(`^a<b$`)),
+		},
+		"email": {
+			vr.Email(),
+		},
+	}
+
+	var output bytes.Buffer
+
+	// when
+	err := tmpl.Execute(&output, map[string]any{
+		"Rules":      rules,
+		"Attributes": For(rules, "email").HTMLAttr(),
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, `<input name="name" required pattern="^a&lt;b$"><input type="email">`, output.String())
+}
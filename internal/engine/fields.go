@@ -0,0 +1,215 @@
+package engine
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type FieldValue struct {
+	Field   string
+	Value   any
+	Missing bool
+	Groups  [][]string
+}
+
+func WalkField(field string, data any, visit func(fieldValue FieldValue)) {
+	fieldParts := strings.Split(field, ".")
+
+	walkFieldPart(visit, make([]string, len(fieldParts)), fieldParts, 0, data, nil)
+}
+
+func walkFieldPart(visit func(FieldValue), fieldName []string, fieldParts []string, position int, value any, groups [][]string) {
+	isNil := false
+	if value == nil {
+		isNil = true
+	} else {
+		value, isNil = Dereference(value)
+	}
+
+	if isNil {
+		if len(fieldParts) == position {
+			visit(FieldValue{
+				Field:  strings.Join(fieldName, "."),
+				Value:  nil,
+				Groups: groups,
+			})
+
+			return
+		}
+
+		visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+		return
+	}
+
+	if len(fieldParts) == position {
+		visit(FieldValue{
+			Field:  strings.Join(fieldName, "."),
+			Value:  value,
+			Groups: groups,
+		})
+
+		return
+	}
+
+	valueOf := reflect.ValueOf(value)
+
+	if fieldParts[position] == "*" {
+		if valueOf.Kind() == reflect.Slice || valueOf.Kind() == reflect.Array {
+			for idx := 0; idx < valueOf.Len(); idx++ {
+				fieldName[position] = strconv.Itoa(idx)
+
+				walkFieldPart(visit, fieldName, fieldParts, position+1, valueOf.Index(idx).Interface(), groups)
+			}
+
+			return
+		}
+
+		visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+		return
+	}
+
+	switch valueOf.Kind() {
+	case reflect.Map:
+		key, ok := toMapKey(valueOf.Type().Key(), fieldParts[position])
+		if !ok {
+			visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+			return
+		}
+
+		mapIndex := valueOf.MapIndex(key)
+		if !mapIndex.IsValid() {
+			visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+			return
+		}
+
+		value = mapIndex.Interface()
+
+	case reflect.Struct:
+		typeOf := reflect.TypeOf(value)
+		structField, found := typeOf.FieldByName(fieldParts[position])
+		if !found {
+			for idx := 0; idx < typeOf.NumField(); idx++ {
+				nameFromTag := typeOf.Field(idx).Tag.Get("validation")
+				if nameFromTag != "" && nameFromTag == fieldParts[position] {
+					structField = typeOf.Field(idx)
+					found = true
+				}
+			}
+		}
+
+		if !found {
+			visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+			return
+		}
+
+		value = valueOf.FieldByIndex(structField.Index).Interface()
+
+		if groupsFromTag := structField.Tag.Get("validationGroups"); groupsFromTag != "" {
+			groups = append(groups[:len(groups):len(groups)], parseGroupsTag(groupsFromTag))
+		}
+
+	case reflect.Slice, reflect.Array:
+		idx, err := strconv.Atoi(fieldParts[position])
+		if err != nil || idx < 0 || idx >= valueOf.Len() {
+			visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+			return
+		}
+
+		value = valueOf.Index(idx).Interface()
+
+	default:
+		visitMissingField(visit, fieldName, fieldParts, position, groups)
+
+		return
+	}
+
+	fieldName[position] = fieldParts[position]
+
+	walkFieldPart(visit, fieldName, fieldParts, position+1, value, groups)
+}
+
+func visitMissingField(visit func(FieldValue), fieldName []string, fieldParts []string, position int, groups [][]string) {
+	for idx := position; idx < len(fieldParts); idx++ {
+		fieldName[idx] = fieldParts[idx]
+	}
+
+	visit(FieldValue{
+		Field:   strings.Join(fieldName, "."),
+		Value:   nil,
+		Missing: true,
+		Groups:  groups,
+	})
+}
+
+func Dereference(reference any) (value any, isNil bool) {
+	switch valueOf := reflect.ValueOf(reference); valueOf.Kind() {
+	case reflect.Invalid:
+		return nil, true
+
+	case reflect.Ptr,
+		reflect.Interface:
+		if valueOf.IsNil() {
+			return nil, true
+		}
+
+		return Dereference(valueOf.Elem().Interface())
+
+	case reflect.Slice,
+		reflect.Map,
+		reflect.Func,
+		reflect.Chan:
+		if valueOf.IsNil() {
+			return valueOf.Interface(), true
+		}
+	}
+
+	return reference, false
+}
+
+func parseGroupsTag(tag string) []string {
+	groups := strings.Split(tag, ",")
+
+	for idx, group := range groups {
+		groups[idx] = strings.TrimSpace(group)
+	}
+
+	return groups
+}
+
+func toMapKey(keyType reflect.Type, fieldPart string) (reflect.Value, bool) {
+	switch keyType.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(fieldPart).Convert(keyType), true
+
+	case reflect.Interface:
+		key := reflect.ValueOf(fieldPart)
+
+		return key, key.Type().AssignableTo(keyType)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		key, err := strconv.ParseInt(fieldPart, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+
+		return reflect.ValueOf(key).Convert(keyType), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		key, err := strconv.ParseUint(fieldPart, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+
+		return reflect.ValueOf(key).Convert(keyType), true
+
+	default:
+		return reflect.Value{}, false
+	}
+}
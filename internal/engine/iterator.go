@@ -0,0 +1,11 @@
+package engine
+
+import (
+	"context"
+)
+
+type iterator[R Rule] interface {
+	Current() R
+	Next(ctx context.Context, value any, data any)
+	Valid() bool
+}
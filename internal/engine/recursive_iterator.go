@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"context"
+)
+
+type expansionListener[R Rule] func(rule R, subRules []R, value any)
+
+func newRecursiveIterator[R Rule](rules []R, ctx context.Context, value any, data any) *recursiveIterator[R] {
+	return newListeningRecursiveIterator(rules, ctx, value, data, nil)
+}
+
+func newListeningRecursiveIterator[R Rule](rules []R, ctx context.Context, value any, data any, listener expansionListener[R]) *recursiveIterator[R] {
+	ri := &recursiveIterator[R]{
+		iterator: newRulesIterator(rules),
+		listener: listener,
+	}
+
+	if ri.iterate(ctx, value, data); !ri.Valid() {
+		return &recursiveIterator[R]{iterator: nil}
+	}
+
+	return ri
+}
+
+type recursiveIterator[R Rule] struct {
+	iterator iterator[R]
+	parent   stack[R]
+	listener expansionListener[R]
+}
+
+func (i *recursiveIterator[R]) Current() R {
+	if !i.Valid() {
+		var rule R
+
+		return rule
+	}
+
+	return i.iterator.Current()
+}
+
+func (i *recursiveIterator[R]) Next(ctx context.Context, value any, data any) {
+	if !i.Valid() {
+		return
+	}
+
+	i.iterator.Next(ctx, value, data)
+
+	i.iterate(ctx, value, data)
+}
+
+func (i *recursiveIterator[R]) Valid() bool {
+	return i.iterator != nil
+}
+
+func (i *recursiveIterator[R]) iterate(ctx context.Context, value any, data any) {
+	for {
+		if !i.iterator.Valid() {
+			if i.parent.Empty() {
+				i.iterator = nil
+
+				break
+			}
+
+			i.iterator = i.parent.Pop()
+
+			continue
+		}
+
+		rule := i.iterator.Current()
+
+		if withSubRules, ok := any(rule).(withSubRulesRule[R]); ok {
+			i.parent.Push(i.iterator)
+			i.iterator.Next(ctx, value, data)
+
+			subRules := withSubRules.Rules(ctx, value, data)
+			if i.listener != nil {
+				i.listener(rule, subRules, value)
+			}
+
+			i.iterator = newRulesIterator(subRules)
+
+			continue
+		}
+
+		break
+	}
+}
+
+type stack[R Rule] []iterator[R]
+
+func (s *stack[R]) Push(v iterator[R]) {
+	*s = append(*s, v)
+}
+
+func (s *stack[R]) Pop() iterator[R] {
+	l := len(*s)
+	i := (*s)[l-1]
+	*s = (*s)[:l-1]
+
+	return i
+}
+
+func (s stack[R]) Empty() bool {
+	return len(s) == 0
+}
@@ -1,4 +1,4 @@
-package validator
+package engine
 
 import (
 	"context"
@@ -8,7 +8,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ve "github.com/donatorsky/go-validator/error"
-	vr "github.com/donatorsky/go-validator/rule"
 )
 
 func Test_recursiveIterator(t *testing.T) {
@@ -23,55 +22,55 @@ func Test_recursiveIterator(t *testing.T) {
 	)
 
 	for ttIdx, tt := range []struct {
-		rules         []vr.Rule
-		expectedRules []vr.Rule
+		rules         []Rule
+		expectedRules []Rule
 	}{
 		{
 			rules:         nil,
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				ruleMock1,
 				ruleMock2,
 				ruleMock3,
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				newRuleWithSubRulesMock(nil),
 			},
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				newRuleWithSubRulesMock(nil),
 				newRuleWithSubRulesMock(nil),
 			},
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
 					newRuleWithSubRulesMock(nil),
 				}),
 			},
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock1,
 					ruleMock2,
 					ruleMock3,
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock1,
 					ruleMock2,
 					ruleMock3,
@@ -79,24 +78,24 @@ func Test_recursiveIterator(t *testing.T) {
 					return false
 				}),
 			},
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
-					newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock1,
 						ruleMock2,
 						ruleMock3,
 					}),
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
-					newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock1,
 						ruleMock2,
 						ruleMock3,
@@ -105,12 +104,12 @@ func Test_recursiveIterator(t *testing.T) {
 					return false
 				}),
 			},
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
-					newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock1,
 						ruleMock2,
 						ruleMock3,
@@ -119,105 +118,105 @@ func Test_recursiveIterator(t *testing.T) {
 					}),
 				}),
 			},
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock1,
-					newRuleWithSubRulesMock([]vr.Rule{ruleMock2}).
+					newRuleWithSubRulesMock([]Rule{ruleMock2}).
 						WithRulesCondition(func(_ context.Context, _ any, _ any) bool {
 							return false
 						}),
 					ruleMock3,
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock1,
-					newRuleWithSubRulesMock([]vr.Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock2,
 					}),
 					ruleMock3,
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				ruleMock1,
-				newRuleWithSubRulesMock([]vr.Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock2,
 				}),
 				ruleMock3,
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				ruleMock1,
 				ruleMock2,
-				newRuleWithSubRulesMock([]vr.Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock3,
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				ruleMock1,
 				ruleMock2,
 				newRuleWithSubRulesMock(nil),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2},
+			expectedRules: []Rule{ruleMock1, ruleMock2},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				ruleMock1,
 				ruleMock2,
-				newRuleWithSubRulesMock([]vr.Rule{
+				newRuleWithSubRulesMock([]Rule{
 					newRuleWithSubRulesMock(nil),
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2},
+			expectedRules: []Rule{ruleMock1, ruleMock2},
 		},
 		{
-			rules: []vr.Rule{
+			rules: []Rule{
 				ruleMock1,
 				ruleMock2,
-				newRuleWithSubRulesMock([]vr.Rule{
-					newRuleWithSubRulesMock([]vr.Rule{
+				newRuleWithSubRulesMock([]Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock3,
 					}),
 				}),
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 		{
-			rules: []vr.Rule{
-				newRuleWithSubRulesMock([]vr.Rule{
+			rules: []Rule{
+				newRuleWithSubRulesMock([]Rule{
 					ruleMock1,
-					newRuleWithSubRulesMock([]vr.Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock2,
 					}),
 				}),
 				ruleMock3,
-				newRuleWithSubRulesMock([]vr.Rule{
-					newRuleWithSubRulesMock([]vr.Rule{
+				newRuleWithSubRulesMock([]Rule{
+					newRuleWithSubRulesMock([]Rule{
 						ruleMock4,
 					}),
 					ruleMock5,
 				}),
 				ruleMock6,
 			},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3, ruleMock4, ruleMock5, ruleMock6},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3, ruleMock4, ruleMock5, ruleMock6},
 		},
 	} {
 		t.Run(fmt.Sprintf("Test data #%d", ttIdx), func(t *testing.T) {
-			var rules = make([]vr.Rule, 0, len(tt.expectedRules))
+			var rules = make([]Rule, 0, len(tt.expectedRules))
 
 			i := newRecursiveIterator(tt.rules, context.Background(), nil, nil)
 
@@ -236,7 +235,7 @@ func Test_recursiveIterator(t *testing.T) {
 
 func Test_recursiveIterator_ReturnsNilForEmptyIterator(t *testing.T) {
 	// given
-	i := newRecursiveIterator(nil, context.Background(), nil, nil)
+	i := newRecursiveIterator[Rule](nil, context.Background(), nil, nil)
 
 	// then
 	require.Nil(t, i.Current())
@@ -254,7 +253,7 @@ func Test_recursiveIterator_ReturnsNilForEmptyIterator(t *testing.T) {
 
 func Test_stack(t *testing.T) {
 	// given
-	s := stack{}
+	s := stack[Rule]{}
 
 	// then
 	require.Len(t, s, 0)
@@ -275,12 +274,12 @@ func Test_stack(t *testing.T) {
 	require.True(t, s.Empty())
 }
 
-func newRuleWithSubRulesMock(rules []vr.Rule) *ruleWithSubRulesMock {
+func newRuleWithSubRulesMock(rules []Rule) *ruleWithSubRulesMock {
 	return &ruleWithSubRulesMock{rules: rules}
 }
 
 type ruleWithSubRulesMock struct {
-	rules          []vr.Rule
+	rules          []Rule
 	rulesCondition func(ctx context.Context, value any, data any) bool
 }
 
@@ -292,7 +291,7 @@ func (*ruleWithSubRulesMock) Bails() bool {
 	panic("unexpected method call")
 }
 
-func (r *ruleWithSubRulesMock) Rules(ctx context.Context, value any, data any) []vr.Rule {
+func (r *ruleWithSubRulesMock) Rules(ctx context.Context, value any, data any) []Rule {
 	if r.rulesCondition == nil || r.rulesCondition(ctx, value, data) {
 		return r.rules
 	}
@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"context"
+)
+
+func newRulesIterator[R Rule](rules []R) *rulesIterator[R] {
+	return &rulesIterator[R]{
+		rules: rules,
+		index: 0,
+	}
+}
+
+type rulesIterator[R Rule] struct {
+	rules []R
+	index int
+}
+
+func (i *rulesIterator[R]) Current() R {
+	if !i.Valid() {
+		var rule R
+
+		return rule
+	}
+
+	return i.rules[i.index]
+}
+
+func (i *rulesIterator[R]) Next(_ context.Context, _ any, _ any) {
+	if !i.Valid() {
+		return
+	}
+
+	i.index++
+}
+
+func (i *rulesIterator[R]) Valid() bool {
+	return i.index < len(i.rules)
+}
@@ -1,4 +1,4 @@
-package validator
+package engine
 
 import (
 	"context"
@@ -8,7 +8,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ve "github.com/donatorsky/go-validator/error"
-	vr "github.com/donatorsky/go-validator/rule"
 )
 
 func Test_rulesIterator_CanIterate(t *testing.T) {
@@ -20,20 +19,20 @@ func Test_rulesIterator_CanIterate(t *testing.T) {
 	)
 
 	for ttName, tt := range map[string]struct {
-		rules         []vr.Rule
-		expectedRules []vr.Rule
+		rules         []Rule
+		expectedRules []Rule
 	}{
 		"empty slice": {
 			rules:         nil,
-			expectedRules: []vr.Rule{},
+			expectedRules: []Rule{},
 		},
 		"non-empty slice": {
-			rules:         []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
-			expectedRules: []vr.Rule{ruleMock1, ruleMock2, ruleMock3},
+			rules:         []Rule{ruleMock1, ruleMock2, ruleMock3},
+			expectedRules: []Rule{ruleMock1, ruleMock2, ruleMock3},
 		},
 	} {
 		t.Run(ttName, func(t *testing.T) {
-			var rules = make([]vr.Rule, 0, len(tt.expectedRules))
+			var rules = make([]Rule, 0, len(tt.expectedRules))
 
 			i := newRulesIterator(tt.rules)
 
@@ -52,7 +51,7 @@ func Test_rulesIterator_CanIterate(t *testing.T) {
 
 func Test_rulesIterator_ReturnsNilForEmptyIterator(t *testing.T) {
 	// given
-	i := newRulesIterator(nil)
+	i := newRulesIterator[Rule](nil)
 
 	// then
 	require.Nil(t, i.Current())
@@ -0,0 +1,205 @@
+package engine
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type Rule interface {
+	Apply(ctx context.Context, value any, data any) (newValue any, err ve.ValidationError)
+}
+
+type withSubRulesRule[R Rule] interface {
+	Rules(ctx context.Context, value any, data any) []R
+}
+
+type nestedRule[R Rule] interface {
+	NestedRules(ctx context.Context, value any, data any) map[string][]R
+}
+
+type elementsRule[R Rule] interface {
+	Elements(ctx context.Context, value any, data any) []Target[R]
+}
+
+type presenceAwareRule interface {
+	ApplyWithPresence(ctx context.Context, value any, present bool, data any) (newValue any, err ve.ValidationError)
+}
+
+type bailingRule interface {
+	Bails() bool
+}
+
+type skippingRule interface {
+	Skips() bool
+}
+
+type combiningRule interface {
+	Combines() bool
+}
+
+type Target[R Rule] struct {
+	FieldValue
+
+	Rules []R
+}
+
+type NestedFunc[R Rule] func(ctx context.Context, data any, targets []Target[R]) (ve.ErrorsBag, error)
+
+type Listener[R Rule] interface {
+	RulesExpanded(rule R, subRules []R, value any)
+	RuleApplied(rule R, input, output any, err ve.ValidationError, duration time.Duration)
+	RulesStopped(bailed bool)
+}
+
+type Runner[R Rule] struct {
+	Nested     NestedFunc[R]
+	Combinator NestedFunc[R]
+	Listener   Listener[R]
+}
+
+type Result struct {
+	Value   any
+	Errors  []ve.ValidationError
+	Nested  ve.ErrorsBag
+	Failed  bool
+	Skipped bool
+	Missing bool
+}
+
+func (r Runner[R]) Run(ctx context.Context, value any, present bool, data any, rules []R) (Result, error) {
+	result := Result{
+		Value:   value,
+		Nested:  ve.NewErrorsBag(),
+		Missing: !present,
+	}
+
+	var listener expansionListener[R]
+	if r.Listener != nil {
+		listener = r.Listener.RulesExpanded
+	}
+
+	i := newListeningRecursiveIterator(rules, ctx, value, data, listener)
+
+	for i.Valid() {
+		rule := i.Current()
+
+		var (
+			err       ve.ValidationError
+			input     = result.Value
+			startedAt = time.Now()
+		)
+
+		ruleCtx := ctx
+		if combiningRule, ok := any(rule).(combiningRule); ok && r.Combinator != nil && combiningRule.Combines() {
+			ruleCtx = ContextWithNestedFunc(ctx, r.Combinator)
+		}
+
+		if presenceAwareRule, ok := any(rule).(presenceAwareRule); ok {
+			result.Value, err = presenceAwareRule.ApplyWithPresence(ruleCtx, result.Value, !result.Missing, data)
+		} else {
+			result.Value, err = rule.Apply(ruleCtx, result.Value, data)
+		}
+
+		validationErrors := ve.Flatten(err)
+		if len(validationErrors) == 0 {
+			err = nil
+		}
+
+		if r.Listener != nil {
+			r.Listener.RuleApplied(rule, input, result.Value, err, time.Since(startedAt))
+		}
+
+		for _, validationError := range validationErrors {
+			if ve.SeverityOf(validationError) == ve.SeverityError {
+				result.Failed = true
+			}
+		}
+
+		result.Errors = append(result.Errors, validationErrors...)
+
+		var targets []Target[R]
+		if nestedRule, ok := any(rule).(nestedRule[R]); ok {
+			targets = Targets(nestedRule.NestedRules(ctx, result.Value, data), result.Value)
+		} else if elementsRule, ok := any(rule).(elementsRule[R]); ok {
+			targets = elementsRule.Elements(ctx, result.Value, data)
+		}
+
+		if targets != nil && r.Nested != nil {
+			nestedErrorsBag, err := r.Nested(ctx, result.Value, targets)
+			if err != nil {
+				return result, err
+			}
+
+			for field, errors := range nestedErrorsBag {
+				result.Nested.Add(field, errors...)
+			}
+
+			if nestedErrorsBag.Any() {
+				result.Failed = true
+			}
+		}
+
+		if result.Missing && result.Value != nil {
+			result.Missing = false
+		}
+
+		if bailingRule, ok := any(rule).(bailingRule); ok && result.Failed && bailingRule.Bails() {
+			if r.Listener != nil {
+				r.Listener.RulesStopped(true)
+			}
+
+			break
+		}
+
+		if skippingRule, ok := any(rule).(skippingRule); ok && skippingRule.Skips() {
+			if r.Listener != nil {
+				r.Listener.RulesStopped(false)
+			}
+
+			result.Skipped = true
+
+			break
+		}
+
+		i.Next(ctx, result.Value, data)
+	}
+
+	return result, nil
+}
+
+func Targets[R Rule](rules map[string][]R, data any) []Target[R] {
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	var targets []Target[R]
+
+	for _, field := range fields {
+		WalkField(field, data, func(fieldValue FieldValue) {
+			targets = append(targets, Target[R]{
+				FieldValue: fieldValue,
+				Rules:      rules[field],
+			})
+		})
+	}
+
+	return targets
+}
+
+type nestedFuncContextKey struct{}
+
+func ContextWithNestedFunc[R Rule](ctx context.Context, nested NestedFunc[R]) context.Context {
+	return context.WithValue(ctx, nestedFuncContextKey{}, nested)
+}
+
+func NestedFuncFromContext[R Rule](ctx context.Context) (NestedFunc[R], bool) {
+	nested, ok := ctx.Value(nestedFuncContextKey{}).(NestedFunc[R])
+
+	return nested, ok
+}
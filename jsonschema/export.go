@@ -0,0 +1,403 @@
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type UnsupportedRule struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+}
+
+func (r UnsupportedRule) String() string {
+	return fmt.Sprintf("%s: %s", r.Field, r.Rule)
+}
+
+func Export(rules validator.RulesMap) (*Schema, []UnsupportedRule) {
+	root := &Schema{
+		Type: Types{TypeObject},
+	}
+
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	var unsupported []UnsupportedRule
+
+	descriptors := make(map[string][]vr.RuleDescriptor, len(fields))
+
+	for _, field := range fields {
+		descriptors[field] = make([]vr.RuleDescriptor, 0, len(rules[field]))
+
+		for _, rule := range rules[field] {
+			describableRule, ok := rule.(vr.DescribableRule)
+			if !ok {
+				unsupported = append(unsupported, UnsupportedRule{Field: field, Rule: fmt.Sprintf("%T", rule)})
+
+				continue
+			}
+
+			descriptors[field] = append(descriptors[field], describableRule.Describe())
+		}
+	}
+
+	unsupported = append(unsupported, exportFields(root, "", descriptors)...)
+
+	inferTypes(root)
+
+	root.Schema = Draft202012
+
+	return root, unsupported
+}
+
+func exportFields(root *Schema, parentField string, fields map[string][]vr.RuleDescriptor) []UnsupportedRule {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+
+	sort.Strings(names)
+
+	var unsupported []UnsupportedRule
+
+	for _, field := range names {
+		unsupported = append(unsupported, exportField(root, parentField, field, fields[field])...)
+	}
+
+	return unsupported
+}
+
+func exportField(root *Schema, parentField, field string, descriptors []vr.RuleDescriptor) []UnsupportedRule {
+	parent, schema, name := resolveSchema(root, field)
+	descriptors = withoutParentPresent(descriptors)
+
+	path := field
+	if parentField != "" {
+		path = parentField + "." + field
+	}
+
+	for _, descriptor := range descriptors {
+		applyTypeDescriptor(schema, descriptor)
+	}
+
+	var (
+		unsupported []UnsupportedRule
+		sometimes   bool
+	)
+
+	for _, descriptor := range descriptors {
+		switch {
+		case descriptor.Name == ve.RuleSometimes:
+			sometimes = true
+
+		case descriptor.Name == ve.RuleRequired, descriptor.Name == ve.RulePresent:
+			if name != "*" && !sometimes {
+				parent.Required = append(parent.Required, name)
+			}
+
+		case descriptor.Name == ve.RuleNested:
+			unsupported = append(unsupported, exportFields(schema, path, descriptor.Fields)...)
+
+		case descriptor.Name == ve.RuleEach && (schema.Type.Has(TypeArray) || !schema.Type.Has(TypeObject)):
+			unsupported = append(unsupported, exportField(schema, path, "*", descriptor.Rules)...)
+
+		case !applyDescriptor(schema, descriptor):
+			unsupported = append(unsupported, UnsupportedRule{Field: path, Rule: descriptor.Name})
+		}
+	}
+
+	return unsupported
+}
+
+func withoutParentPresent(descriptors []vr.RuleDescriptor) []vr.RuleDescriptor {
+	unwrapped := make([]vr.RuleDescriptor, 0, len(descriptors))
+
+	for _, descriptor := range descriptors {
+		if descriptor.Name == RuleParentPresent {
+			unwrapped = append(unwrapped, withoutParentPresent(descriptor.Rules)...)
+		} else {
+			unwrapped = append(unwrapped, descriptor)
+		}
+	}
+
+	return unwrapped
+}
+
+func resolveSchema(root *Schema, field string) (parent, schema *Schema, name string) {
+	schema = root
+
+	for _, part := range strings.Split(field, ".") {
+		parent, name = schema, part
+
+		if part == "*" {
+			if schema.Items == nil {
+				schema.Items = &Schema{}
+			}
+
+			schema = schema.Items
+
+			continue
+		}
+
+		if schema.Properties == nil {
+			schema.Properties = map[string]*Schema{}
+		}
+
+		if schema.Properties[part] == nil {
+			schema.Properties[part] = &Schema{}
+		}
+
+		schema = schema.Properties[part]
+	}
+
+	return parent, schema, name
+}
+
+func inferTypes(schema *Schema) {
+	if schema.Items != nil {
+		if len(schema.Type) == 0 {
+			schema.Type = Types{TypeArray}
+		}
+
+		inferTypes(schema.Items)
+	}
+
+	if schema.Properties != nil && len(schema.Type) == 0 {
+		schema.Type = Types{TypeObject}
+	}
+
+	for _, property := range schema.Properties {
+		inferTypes(property)
+	}
+}
+
+func applyTypeDescriptor(schema *Schema, descriptor vr.RuleDescriptor) {
+	var typ string
+
+	switch descriptor.Name {
+	case ve.RuleString:
+		typ = TypeString
+
+	case ve.RuleInt:
+		typ = TypeInteger
+
+	case ve.RuleFloat:
+		typ = TypeNumber
+
+	case ve.RuleBoolean:
+		typ = TypeBoolean
+
+	case ve.RuleSlice, ve.RuleArray:
+		typ = TypeArray
+
+	case ve.RuleMap, ve.RuleStruct:
+		typ = TypeObject
+
+	default:
+		return
+	}
+
+	if !schema.Type.Has(typ) {
+		schema.Type = append(Types{typ}, schema.Type...)
+	}
+}
+
+func applyDescriptor(schema *Schema, descriptor vr.RuleDescriptor) bool {
+	switch descriptor.Name {
+	case ve.RuleString, ve.RuleInt, ve.RuleFloat, ve.RuleBoolean, ve.RuleSlice, ve.RuleArray, ve.RuleMap, ve.RuleStruct:
+		return true
+
+	case ve.RuleBail:
+		return true
+
+	case ve.RuleDefault:
+		value, ok := descriptor.Params["value"]
+		schema.Default = value
+
+		return ok
+
+	case ve.RuleNullable:
+		if len(schema.Type) > 0 && !schema.Type.Has(TypeNull) {
+			schema.Type = append(schema.Type, TypeNull)
+		}
+
+		return true
+
+	case ve.RuleMin:
+		threshold, _ := vr.ToFloat(descriptor.Params["threshold"])
+
+		return applyBound(schema, threshold, descriptor.Params["inclusive"] == true, true)
+
+	case ve.RuleMax:
+		threshold, _ := vr.ToFloat(descriptor.Params["threshold"])
+
+		return applyBound(schema, threshold, descriptor.Params["inclusive"] == true, false)
+
+	case ve.RuleBetween:
+		minimum, _ := vr.ToFloat(descriptor.Params["min"])
+		maximum, _ := vr.ToFloat(descriptor.Params["max"])
+		inclusive := descriptor.Params["inclusive"] == true
+
+		return applyBound(schema, minimum, inclusive, true) && applyBound(schema, maximum, inclusive, false)
+
+	case ve.RuleLength:
+		length, _ := vr.ToFloat(descriptor.Params["length"])
+
+		return applyBound(schema, length, true, true) && applyBound(schema, length, true, false)
+
+	case ve.RuleFilled:
+		switch {
+		case schema.Type.Has(TypeString), schema.Type.Has(TypeArray), schema.Type.Has(TypeObject):
+			return applyBound(schema, 1, true, true)
+
+		default:
+			return false
+		}
+
+	case ve.RuleIn:
+		schema.Enum = vr.ToSlice(descriptor.Params["values"])
+
+		return true
+
+	case ve.RuleNotIn:
+		schema.Not = &Schema{Enum: vr.ToSlice(descriptor.Params["values"])}
+
+		return true
+
+	case ve.RuleRegex:
+		schema.Pattern, _ = descriptor.Params["pattern"].(string)
+
+		return true
+
+	case ve.RuleNotRegex:
+		pattern, _ := descriptor.Params["pattern"].(string)
+		schema.Not = &Schema{Pattern: pattern}
+
+		return true
+
+	case ve.RuleUUID:
+		schema.Format = "uuid"
+
+		return true
+
+	case ve.RuleEmail:
+		schema.Format = "email"
+
+		return true
+
+	case ve.RuleURL:
+		schema.Format = "uri"
+
+		return true
+
+	case ve.RuleIP:
+		schema.AnyOf = []*Schema{{Format: "ipv4"}, {Format: "ipv6"}}
+
+		return true
+
+	case ve.RuleDateFormat:
+		switch descriptor.Params["format"] {
+		case time.RFC3339, time.RFC3339Nano:
+			schema.Format = "date-time"
+
+		case "2006-01-02":
+			schema.Format = "date"
+
+		case "15:04:05":
+			schema.Format = "time"
+
+		default:
+			return false
+		}
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+func applyBound(schema *Schema, threshold float64, inclusive, lower bool) bool {
+	types := schema.Type
+	if len(types) == 0 {
+		types = Types{TypeNumber, TypeString, TypeArray, TypeObject}
+	}
+
+	applied := false
+
+	for _, typ := range types {
+		switch typ {
+		case TypeNumber, TypeInteger:
+			value := threshold
+
+			switch {
+			case lower && inclusive:
+				schema.Minimum = &value
+
+			case lower:
+				schema.ExclusiveMinimum = &value
+
+			case inclusive:
+				schema.Maximum = &value
+
+			default:
+				schema.ExclusiveMaximum = &value
+			}
+
+		case TypeString:
+			schema.MinLength, schema.MaxLength = countBound(schema.MinLength, schema.MaxLength, threshold, inclusive, lower)
+
+		case TypeArray:
+			schema.MinItems, schema.MaxItems = countBound(schema.MinItems, schema.MaxItems, threshold, inclusive, lower)
+
+		case TypeObject:
+			schema.MinProperties, schema.MaxProperties = countBound(schema.MinProperties, schema.MaxProperties, threshold, inclusive, lower)
+
+		default:
+			continue
+		}
+
+		applied = true
+	}
+
+	return applied
+}
+
+func countBound(minimum, maximum *int, threshold float64, inclusive, lower bool) (*int, *int) {
+	var count int
+
+	switch {
+	case lower && inclusive:
+		count = int(math.Ceil(threshold))
+
+	case lower:
+		count = int(math.Floor(threshold)) + 1
+
+	case inclusive:
+		count = int(math.Floor(threshold))
+
+	default:
+		count = int(math.Ceil(threshold)) - 1
+	}
+
+	if lower {
+		if count < 0 {
+			count = 0
+		}
+
+		return &count, maximum
+	}
+
+	return minimum, &count
+}
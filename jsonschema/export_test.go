@@ -0,0 +1,229 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_Export(t *testing.T) {
+	// given
+	rules := validator.RulesMap{
+		"id": {
+			vr.Required(),
+			vr.UUID(),
+		},
+		"name": {
+			vr.Required(),
+			vr.String(),
+			vr.Between(3, 64),
+		},
+		"email": {
+			vr.Sometimes(),
+			vr.Bail(),
+			vr.Required(),
+			vr.Email(),
+		},
+		"country": {
+			vr.Present(),
+			vr.Default("PL"),
+		},
+		"nickname": {
+			vr.DefaultFunc(func(_ context.Context, _ any) any {
+				return "anonymous"
+			}),
+		},
+		"age": {
+			vr.Integer[int](),
+			vr.Nullable(),
+			vr.MinExclusive(17),
+			vr.Max(130),
+		},
+		"role": {
+			vr.In([]string{"admin", "user"}),
+		},
+		"code": {
+			vr.Regex(regexp.MustCompile(`^[A-Z]{3}$`)),
+			vr.NotIn([]string{"FOO"}),
+		},
+		"score": {
+			vr.Min(1.5),
+		},
+		"tags": {
+			vr.Slice(),
+			vr.Filled(),
+			vr.MaxExclusive(5),
+		},
+		"tags.*": {
+			vr.Required(),
+			vr.String(),
+			vr.Length(4),
+		},
+		"address.city": {
+			vr.Required(),
+			vr.String(),
+		},
+		"address.ip": {
+			vr.IP(),
+		},
+		"created_at": {
+			vr.Date(),
+		},
+		"birthday": {
+			vr.DateFormat("2006-01-02"),
+			vr.Custom(func(_ context.Context, value string, _ any) (string, error) {
+				return value, nil
+			}),
+		},
+		"website": {
+			vr.URL(),
+			vr.Numeric(),
+		},
+	}
+
+	// when
+	schema, unsupported := Export(rules)
+
+	// then
+	require.ElementsMatch(t, []UnsupportedRule{
+		{Field: "birthday", Rule: ve.RuleCustom},
+		{Field: "nickname", Rule: ve.RuleDefault},
+		{Field: "website", Rule: ve.RuleNumeric},
+	}, unsupported)
+
+	actual, err := json.MarshalIndent(schema, "", "  ")
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["country", "id", "name"],
+		"properties": {
+			"address": {
+				"type": "object",
+				"required": ["city"],
+				"properties": {
+					"city": {"type": "string"},
+					"ip": {"anyOf": [{"format": "ipv4"}, {"format": "ipv6"}]}
+				}
+			},
+			"age": {"type": ["integer", "null"], "exclusiveMinimum": 17, "maximum": 130},
+			"birthday": {"format": "date"},
+			"country": {"default": "PL"},
+			"nickname": {},
+			"code": {"pattern": "^[A-Z]{3}$", "not": {"enum": ["FOO"]}},
+			"created_at": {"format": "date-time"},
+			"email": {"format": "email"},
+			"id": {"format": "uuid"},
+			"name": {"type": "string", "minLength": 3, "maxLength": 64},
+			"role": {"enum": ["admin", "user"]},
+			"score": {"minimum": 1.5, "minLength": 2, "minItems": 2, "minProperties": 2},
+			"tags": {
+				"type": "array",
+				"minItems": 1,
+				"maxItems": 4,
+				"items": {"type": "string", "minLength": 4, "maxLength": 4}
+			},
+			"website": {"format": "uri"}
+		}
+	}`, string(actual))
+}
+
+func Test_Export_InfersTypesOfParents(t *testing.T) {
+	// when
+	schema, unsupported := Export(validator.RulesMap{
+		"matrix.*.*.value": {
+			vr.Required(),
+		},
+	})
+
+	// then
+	require.Empty(t, unsupported)
+	require.Equal(t, &Schema{
+		Schema: Draft202012,
+		Type:   Types{TypeObject},
+		Properties: map[string]*Schema{
+			"matrix": {
+				Type: Types{TypeArray},
+				Items: &Schema{
+					Type: Types{TypeArray},
+					Items: &Schema{
+						Type:     Types{TypeObject},
+						Required: []string{"value"},
+						Properties: map[string]*Schema{
+							"value": {},
+						},
+					},
+				},
+			},
+		},
+	}, schema)
+}
+
+func Test_Export_WithNestedAndEachRules(t *testing.T) {
+	// when
+	schema, unsupported := Export(validator.RulesMap{
+		"address": {
+			vr.Nested(map[string][]vr.Rule{
+				"street": {
+					vr.Required(),
+					vr.String(),
+				},
+				"lines.*": {
+					vr.Required(),
+					vr.Numeric(),
+				},
+			}),
+		},
+		"tags": {
+			vr.Slice(),
+			vr.Each(
+				vr.String(),
+				vr.Max(10),
+			),
+		},
+		"prices": {
+			vr.Map(),
+			vr.Each(
+				vr.Min(0),
+			),
+		},
+	})
+
+	// then
+	require.ElementsMatch(t, []UnsupportedRule{
+		{Field: "address.lines.*", Rule: ve.RuleNumeric},
+		{Field: "prices", Rule: ve.RuleEach},
+	}, unsupported)
+
+	actual, err := json.MarshalIndent(schema, "", "  ")
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"address": {
+				"type": "object",
+				"required": ["street"],
+				"properties": {
+					"lines": {
+						"type": "array",
+						"items": {}
+					},
+					"street": {"type": "string"}
+				}
+			},
+			"prices": {"type": "object"},
+			"tags": {
+				"type": "array",
+				"items": {"type": "string", "maxLength": 10}
+			}
+		}
+	}`, string(actual))
+}
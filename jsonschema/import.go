@@ -0,0 +1,493 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	validator "github.com/donatorsky/go-validator"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+var annotationKeywords = map[string]bool{
+	"$comment":    true,
+	"$id":         true,
+	"$schema":     true,
+	"deprecated":  true,
+	"description": true,
+	"examples":    true,
+	"readOnly":    true,
+	"title":       true,
+	"writeOnly":   true,
+}
+
+var formatRules = map[string]func() vr.Rule{
+	"date":      func() vr.Rule { return vr.DateFormat("2006-01-02") },
+	"date-time": func() vr.Rule { return vr.DateFormat(time.RFC3339) },
+	"email":     func() vr.Rule { return vr.Email() },
+	"ipv4":      func() vr.Rule { return vr.IP() },
+	"ipv6":      func() vr.Rule { return vr.IP() },
+	"time":      func() vr.Rule { return vr.DateFormat("15:04:05") },
+	"uri":       func() vr.Rule { return vr.URL() },
+	"uuid":      func() vr.Rule { return vr.UUID() },
+}
+
+type UnsupportedKeyword struct {
+	Path    string `json:"path"`
+	Keyword string `json:"keyword"`
+}
+
+func (k UnsupportedKeyword) String() string {
+	if k.Path == "" {
+		return k.Keyword
+	}
+
+	return fmt.Sprintf("%s: %s", k.Path, k.Keyword)
+}
+
+func Import(data []byte) (validator.RulesMap, []UnsupportedKeyword, error) {
+	var schema any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, nil, err
+	}
+
+	root, ok := schema.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("schema must be an object")
+	}
+
+	i := importer{
+		rules: validator.RulesMap{},
+	}
+
+	if err := i.importRoot(root); err != nil {
+		return nil, nil, err
+	}
+
+	return i.rules, i.unsupported, nil
+}
+
+type importer struct {
+	rules       validator.RulesMap
+	unsupported []UnsupportedKeyword
+}
+
+func (i *importer) importRoot(schema map[string]any) error {
+	for _, keyword := range sortedKeys(schema) {
+		switch keyword {
+		case "type":
+			if schema[keyword] != TypeObject {
+				i.unsupport("", keyword)
+			}
+
+		case "properties", "required":
+
+		default:
+			if !annotationKeywords[keyword] {
+				i.unsupport("", keyword)
+			}
+		}
+	}
+
+	return i.importProperties("", schema)
+}
+
+func (i *importer) importSchema(path string, raw any, required bool) ([]vr.Rule, error) {
+	var schema map[string]any
+
+	switch raw := raw.(type) {
+	case map[string]any:
+		schema = raw
+
+	case bool:
+		if !raw {
+			i.unsupport(path, "false")
+		}
+
+		schema = map[string]any{}
+
+	default:
+		return nil, fmt.Errorf("%s: schema must be an object or a boolean", path)
+	}
+
+	types, err := parseTypes(path, schema["type"])
+	if err != nil {
+		return nil, err
+	}
+
+	nullable := len(types) == 0
+	nonNullTypes := make([]string, 0, len(types))
+
+	for _, typ := range types {
+		if typ == TypeNull {
+			nullable = true
+		} else {
+			nonNullTypes = append(nonNullTypes, typ)
+		}
+	}
+
+	var rules []vr.Rule
+
+	if value, ok := schema["default"]; ok {
+		rules = append(rules, vr.Default(value))
+	}
+
+	switch {
+	case required && nullable:
+		rules = append(rules, vr.Present())
+
+	case required:
+		rules = append(rules, vr.Required())
+
+	case !nullable:
+		rules = append(rules, vr.Sometimes(), vr.Required())
+
+	case len(nonNullTypes) > 0:
+		rules = append(rules, vr.Nullable())
+	}
+
+	switch len(nonNullTypes) {
+	case 0:
+		if len(types) > 0 {
+			i.unsupport(path, "type")
+		}
+
+	case 1:
+		rules = append(rules, typeRule(nonNullTypes[0]))
+
+	default:
+		ruleSets := make([][]vr.Rule, len(nonNullTypes))
+		for idx, typ := range nonNullTypes {
+			ruleSets[idx] = []vr.Rule{typeRule(typ)}
+		}
+
+		rules = append(rules, vr.AnyOf(ruleSets...))
+	}
+
+	for _, keyword := range sortedKeys(schema) {
+		value := schema[keyword]
+
+		switch keyword {
+		case "type", "default", "properties", "required", "items":
+
+		case "enum", "const":
+			values, ok := value.([]any)
+			if keyword == "const" {
+				values, ok = []any{value}, true
+			}
+
+			if !ok {
+				return nil, fmt.Errorf("%s: %s must be an array", path, keyword)
+			}
+
+			rules = append(rules, enumRule(values))
+
+		case "minimum", "exclusiveMinimum", "maximum", "exclusiveMaximum":
+			number, ok := value.(float64)
+			if !ok {
+				return nil, fmt.Errorf("%s: %s must be a number", path, keyword)
+			}
+
+			rules = append(rules, guard(nonNullTypes, isNumber, boundRule(keyword, number), TypeInteger, TypeNumber))
+
+		case "minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties":
+			count, ok := value.(float64)
+			if !ok || count < 0 || count != math.Trunc(count) {
+				return nil, fmt.Errorf("%s: %s must be a non-negative integer", path, keyword)
+			}
+
+			rule := boundRule(keyword, count)
+
+			switch keyword {
+			case "minLength":
+				rule = guard(nonNullTypes, isString, MinLength(int(count)), TypeString)
+
+			case "maxLength":
+				rule = guard(nonNullTypes, isString, MaxLength(int(count)), TypeString)
+
+			case "minItems", "maxItems":
+				rule = guard(nonNullTypes, isList, rule, TypeArray)
+
+			default:
+				rule = guard(nonNullTypes, isObject, rule, TypeObject)
+			}
+
+			rules = append(rules, rule)
+
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: pattern must be a string", path)
+			}
+
+			regex, err := regexp.Compile(pattern)
+			if err != nil {
+				i.unsupport(path, keyword)
+
+				continue
+			}
+
+			rules = append(rules, guard(nonNullTypes, isString, vr.Regex(regex), TypeString))
+
+		case "format":
+			format, _ := value.(string)
+
+			constructor, ok := formatRules[format]
+			if !ok {
+				i.unsupport(path, fmt.Sprintf("format: %v", value))
+
+				continue
+			}
+
+			rules = append(rules, guard(nonNullTypes, isString, constructor(), TypeString))
+
+		default:
+			if !annotationKeywords[keyword] {
+				i.unsupport(path, keyword)
+			}
+		}
+	}
+
+	if items, ok := schema["items"]; ok {
+		if _, isTuple := items.([]any); isTuple {
+			i.unsupport(path, "items")
+		} else {
+			itemRules, err := i.importSchema(path+".*", items, false)
+			if err != nil {
+				return nil, err
+			}
+
+			i.add(path+".*", itemRules)
+		}
+	}
+
+	if err := i.importProperties(path, schema); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (i *importer) importProperties(path string, schema map[string]any) error {
+	prefix := ""
+	if path != "" {
+		prefix = path + "."
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	if _, ok := schema["properties"]; ok && properties == nil {
+		return fmt.Errorf("%sproperties must be an object", prefix)
+	}
+
+	required := map[string]bool{}
+
+	if value, ok := schema["required"]; ok {
+		names, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%srequired must be an array", prefix)
+		}
+
+		for _, name := range names {
+			name, ok := name.(string)
+			if !ok {
+				return fmt.Errorf("%srequired must contain strings only", prefix)
+			}
+
+			required[name] = true
+
+			if _, ok := properties[name]; !ok {
+				i.add(prefix+name, []vr.Rule{vr.Present()})
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(properties) {
+		propertyRules, err := i.importSchema(prefix+name, properties[name], required[name])
+		if err != nil {
+			return err
+		}
+
+		i.add(prefix+name, propertyRules)
+	}
+
+	return nil
+}
+
+func (i *importer) add(field string, rules []vr.Rule) {
+	if len(rules) == 0 {
+		return
+	}
+
+	if strings.Contains(field, ".") {
+		rules = []vr.Rule{WhenParentPresent(field, rules...)}
+	}
+
+	i.rules[field] = rules
+}
+
+func (i *importer) unsupport(path, keyword string) {
+	i.unsupported = append(i.unsupported, UnsupportedKeyword{Path: path, Keyword: keyword})
+}
+
+func parseTypes(path string, value any) ([]string, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+
+	case string:
+		return parseTypes(path, []any{value})
+
+	case []any:
+		types := make([]string, len(value))
+
+		for idx, typ := range value {
+			typ, ok := typ.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: type must be a string or an array of strings", path)
+			}
+
+			switch typ {
+			case TypeArray, TypeBoolean, TypeInteger, TypeNull, TypeNumber, TypeObject, TypeString:
+				types[idx] = typ
+
+			default:
+				return nil, fmt.Errorf("%s: unknown type %q", path, typ)
+			}
+		}
+
+		return types, nil
+
+	default:
+		return nil, fmt.Errorf("%s: type must be a string or an array of strings", path)
+	}
+}
+
+func typeRule(typ string) vr.Rule {
+	switch typ {
+	case TypeArray:
+		return vr.Slice()
+
+	case TypeBoolean:
+		return vr.Boolean()
+
+	case TypeInteger:
+		return Integer()
+
+	case TypeNumber:
+		return Number()
+
+	case TypeObject:
+		return vr.Map()
+
+	default:
+		return vr.String()
+	}
+}
+
+func enumRule(values []any) vr.Rule {
+	texts := make([]string, len(values))
+	allStrings := true
+
+	for idx, value := range values {
+		if s, ok := value.(string); ok {
+			texts[idx] = s
+
+			continue
+		}
+
+		allStrings = false
+	}
+
+	if allStrings {
+		return vr.In(texts)
+	}
+
+	for idx, value := range values {
+		encoded, _ := json.Marshal(value)
+		texts[idx] = string(encoded)
+	}
+
+	return vr.In(texts, vr.InRuleWithComparator(jsonEquals))
+}
+
+func jsonEquals(value, expectedValue any) bool {
+	if number, ok := vr.ToFloat(value); ok {
+		value = number
+	}
+
+	encoded, err := json.Marshal(value)
+
+	return err == nil && string(encoded) == expectedValue
+}
+
+func boundRule(keyword string, threshold float64) vr.Rule {
+	if threshold != math.Trunc(threshold) {
+		switch keyword {
+		case "minimum":
+			return vr.Min(threshold)
+
+		case "exclusiveMinimum":
+			return vr.MinExclusive(threshold)
+
+		case "maximum":
+			return vr.Max(threshold)
+
+		default:
+			return vr.MaxExclusive(threshold)
+		}
+	}
+
+	switch keyword {
+	case "minimum", "minItems", "minProperties":
+		return vr.Min(int(threshold))
+
+	case "exclusiveMinimum":
+		return vr.MinExclusive(int(threshold))
+
+	case "maximum", "maxItems", "maxProperties":
+		return vr.Max(int(threshold))
+
+	default:
+		return vr.MaxExclusive(int(threshold))
+	}
+}
+
+func guard(types []string, condition func(ctx context.Context, value any, data any) bool, rule vr.Rule, applicableTypes ...string) vr.Rule {
+	if len(types) == 0 {
+		return vr.WhenFunc(condition, rule)
+	}
+
+	for _, typ := range types {
+		applicable := false
+
+		for _, applicableType := range applicableTypes {
+			if typ == applicableType {
+				applicable = true
+
+				break
+			}
+		}
+
+		if !applicable {
+			return vr.WhenFunc(condition, rule)
+		}
+	}
+
+	return rule
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,424 @@
+package jsonschema
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+const importTestSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "User",
+	"type": "object",
+	"required": ["id", "name", "tags", "nickname"],
+	"properties": {
+		"id": {"type": "string", "format": "uuid"},
+		"name": {"type": "string", "minLength": 3, "maxLength": 8, "pattern": "^[a-z]+$"},
+		"email": {"type": "string", "format": "email"},
+		"age": {"type": ["integer", "null"], "minimum": 18, "exclusiveMaximum": 130},
+		"score": {"type": "number", "maximum": 9.5},
+		"role": {"enum": ["admin", "user"]},
+		"level": {"enum": [1, 2, "max"]},
+		"kind": {"const": "person"},
+		"tags": {
+			"type": "array",
+			"minItems": 1,
+			"maxItems": 3,
+			"items": {"type": "string", "maxLength": 4}
+		},
+		"address": {
+			"type": "object",
+			"required": ["city"],
+			"properties": {
+				"city": {"type": "string"},
+				"zip": {"type": "string", "pattern": "^[0-9]{5}$"}
+			}
+		},
+		"size": {"minimum": 2},
+		"country": {"type": "string", "default": "PL"}
+	}
+}`
+
+func Test_Import(t *testing.T) {
+	// given
+	rules, unsupported, err := Import([]byte(importTestSchema))
+	require.NoError(t, err)
+	require.Empty(t, unsupported)
+
+	for ttIdx, tt := range []struct {
+		data           map[string]any
+		expectedErrors map[string][]string
+	}{
+		{
+			data: map[string]any{
+				"id":       "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				"name":     "john",
+				"nickname": nil,
+				"email":    "john@example.com",
+				"age":      nil,
+				"score":    9.5,
+				"role":     "admin",
+				"level":    2,
+				"kind":     "person",
+				"tags":     []any{"a", "bcde"},
+				"address":  map[string]any{"city": "Warsaw", "zip": "00001"},
+				"size":     "ab",
+			},
+			expectedErrors: map[string][]string{},
+		},
+		{
+			data: map[string]any{
+				"name":  "Jo",
+				"email": nil,
+				"age":   1.5,
+				"score": "9",
+				"role":  "root",
+				"level": "2",
+				"kind":  "animal",
+				"tags":  []any{},
+				"size":  1,
+			},
+			expectedErrors: map[string][]string{
+				"id":       {ve.RuleRequired},
+				"name":     {ve.RuleMin, ve.RuleRegex},
+				"nickname": {ve.RulePresent},
+				"email":    {ve.RuleRequired},
+				"age":      {ve.RuleInt},
+				"score":    {ve.RuleFloat},
+				"role":     {ve.RuleIn},
+				"level":    {ve.RuleIn},
+				"kind":     {ve.RuleIn},
+				"tags":     {ve.RuleMin},
+				"size":     {ve.RuleMin},
+			},
+		},
+		{
+			data: map[string]any{
+				"id":       "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				"name":     "john",
+				"nickname": "jj",
+				"age":      130,
+				"score":    10,
+				"tags":     []any{"abcde", 1, "a", "b"},
+				"address":  map[string]any{"city": "Warsaw", "zip": "1"},
+				"country":  nil,
+			},
+			expectedErrors: map[string][]string{
+				"age":         {ve.RuleMax},
+				"score":       {ve.RuleMax},
+				"tags":        {ve.RuleMax},
+				"tags.0":      {ve.RuleMax},
+				"tags.1":      {ve.RuleString},
+				"address.zip": {ve.RuleRegex},
+			},
+		},
+		{
+			data: map[string]any{
+				"id":       "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				"name":     "john",
+				"nickname": nil,
+				"tags":     []any{"a"},
+				"address":  map[string]any{},
+			},
+			expectedErrors: map[string][]string{
+				"address.city": {ve.RuleRequired},
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			errorsBag, err := validator.ForMap(tt.data, rules)
+
+			// then
+			require.NoError(t, err)
+
+			actualErrors := map[string][]string{}
+			for field, validationErrors := range errorsBag {
+				for _, validationError := range validationErrors {
+					actualErrors[field] = append(actualErrors[field], validationError.GetRule())
+				}
+			}
+
+			require.Equal(t, tt.expectedErrors, actualErrors)
+		})
+	}
+}
+
+func Test_Import_AppliesDefaults(t *testing.T) {
+	// given
+	rules, _, err := Import([]byte(`{
+		"type": "object",
+		"properties": {
+			"country": {"type": "string", "default": "PL", "minLength": 3}
+		}
+	}`))
+	require.NoError(t, err)
+
+	// when
+	errorsBag, err := validator.ForMap(map[string]any{}, rules)
+
+	// then
+	require.NoError(t, err)
+	require.Contains(t, errorsBag, "country")
+}
+
+func Test_Import_AppliesNestedPropertiesOnlyWhenParentIsPresent(t *testing.T) {
+	// given
+	rules, _, err := Import([]byte(`{
+		"properties": {
+			"address": {
+				"type": "object",
+				"required": ["street"],
+				"properties": {
+					"street": {"type": "string"}
+				}
+			},
+			"contacts": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"required": ["name"],
+					"properties": {
+						"name": {"type": "string"}
+					}
+				}
+			}
+		}
+	}`))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"address", "address.street", "contacts", "contacts.*", "contacts.*.name"}, sortedRulesKeys(rules))
+
+	for ttIdx, tt := range []struct {
+		data           map[string]any
+		expectedFields []string
+	}{
+		{
+			data:           map[string]any{},
+			expectedFields: nil,
+		},
+		{
+			data:           map[string]any{"address": nil, "contacts": nil},
+			expectedFields: []string{"address", "contacts"},
+		},
+		{
+			data:           map[string]any{"address": "Main", "contacts": "John"},
+			expectedFields: []string{"address", "contacts"},
+		},
+		{
+			data:           map[string]any{"address": map[string]any{}},
+			expectedFields: []string{"address.street"},
+		},
+		{
+			data:           map[string]any{"address": map[string]any{"street": "Main"}},
+			expectedFields: nil,
+		},
+		{
+			data:           map[string]any{"contacts": []any{map[string]any{"name": "John"}, map[string]any{}}},
+			expectedFields: []string{"contacts.1.name"},
+		},
+		{
+			data:           map[string]any{"contacts": []any{"Jane"}},
+			expectedFields: []string{"contacts.0"},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			errorsBag, err := validator.ForMap(tt.data, rules)
+
+			// then
+			require.NoError(t, err)
+
+			var actualFields []string
+			for field := range errorsBag {
+				actualFields = append(actualFields, field)
+			}
+
+			sort.Strings(actualFields)
+
+			require.Equal(t, tt.expectedFields, actualFields)
+		})
+	}
+}
+
+func Test_Import_ExportsBackToSameSchema(t *testing.T) {
+	// given
+	rules, unsupported, err := Import([]byte(importTestSchema))
+	require.NoError(t, err)
+	require.Empty(t, unsupported)
+
+	// when
+	schema, unsupportedRules := Export(rules)
+
+	// then
+	require.Equal(t, []UnsupportedRule{{Field: "size", Rule: ve.RuleWhen}}, unsupportedRules)
+	require.Equal(t, Types{TypeObject}, schema.Properties["address"].Type)
+	require.Equal(t, []string{"city"}, schema.Properties["address"].Required)
+	require.Equal(t, "^[0-9]{5}$", schema.Properties["address"].Properties["zip"].Pattern)
+	require.Equal(t, Types{TypeArray}, schema.Properties["tags"].Type)
+	require.Equal(t, Types{TypeString}, schema.Properties["tags"].Items.Type)
+	require.Equal(t, 4, *schema.Properties["tags"].Items.MaxLength)
+}
+
+func sortedRulesKeys(rules validator.RulesMap) []string {
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func Test_Import_CountsStringLengthInCodePoints(t *testing.T) {
+	// given
+	rules, _, err := Import([]byte(`{
+		"properties": {
+			"name": {"type": "string", "minLength": 3, "maxLength": 4}
+		}
+	}`))
+	require.NoError(t, err)
+
+	for ttIdx, tt := range []struct {
+		value          string
+		expectedErrors map[string][]string
+	}{
+		{
+			value: "żó",
+			expectedErrors: map[string][]string{
+				"name": {ve.RuleMin},
+			},
+		},
+		{
+			value:          "żółw",
+			expectedErrors: map[string][]string{},
+		},
+		{
+			value: "żółwi",
+			expectedErrors: map[string][]string{
+				"name": {ve.RuleMax},
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			errorsBag, err := validator.ForMap(map[string]any{"name": tt.value}, rules)
+
+			// then
+			require.NoError(t, err)
+
+			actualErrors := map[string][]string{}
+			for field, validationErrors := range errorsBag {
+				for _, validationError := range validationErrors {
+					actualErrors[field] = append(actualErrors[field], validationError.GetRule())
+				}
+			}
+
+			require.Equal(t, tt.expectedErrors, actualErrors)
+		})
+	}
+}
+
+func Test_Import_ListsUnsupportedKeywords(t *testing.T) {
+	// when
+	rules, unsupported, err := Import([]byte(`{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"id": {"$ref": "#/$defs/id"},
+			"name": {"type": "string", "format": "hostname", "description": "Name"},
+			"nothing": {"type": "null"},
+			"never": false,
+			"tuple": {"type": "array", "items": [{"type": "string"}]},
+			"choice": {"oneOf": [{"type": "string"}, {"type": "integer"}]},
+			"nested": {"properties": {"deep": {"not": {"type": "string"}}}}
+		}
+	}`))
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []UnsupportedKeyword{
+		{Path: "", Keyword: "additionalProperties"},
+		{Path: "choice", Keyword: "oneOf"},
+		{Path: "id", Keyword: "$ref"},
+		{Path: "name", Keyword: "format: hostname"},
+		{Path: "nested.deep", Keyword: "not"},
+		{Path: "never", Keyword: "false"},
+		{Path: "nothing", Keyword: "type"},
+		{Path: "tuple", Keyword: "items"},
+	}, unsupported)
+	require.Contains(t, rules, "name")
+	require.Equal(t, "name: format: hostname", unsupported[3].String())
+	require.Equal(t, "additionalProperties", unsupported[0].String())
+}
+
+func Test_Import_FailsForInvalidSchema(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		schema        string
+		expectedError string
+	}{
+		{
+			schema:        `[]`,
+			expectedError: "schema must be an object",
+		},
+		{
+			schema:        `{"properties": []}`,
+			expectedError: "properties must be an object",
+		},
+		{
+			schema:        `{"required": "name"}`,
+			expectedError: "required must be an array",
+		},
+		{
+			schema:        `{"required": [1]}`,
+			expectedError: "required must contain strings only",
+		},
+		{
+			schema:        `{"properties": {"name": 1}}`,
+			expectedError: "name: schema must be an object or a boolean",
+		},
+		{
+			schema:        `{"properties": {"name": {"type": "text"}}}`,
+			expectedError: `name: unknown type "text"`,
+		},
+		{
+			schema:        `{"properties": {"name": {"type": 1}}}`,
+			expectedError: "name: type must be a string or an array of strings",
+		},
+		{
+			schema:        `{"properties": {"age": {"minimum": "1"}}}`,
+			expectedError: "age: minimum must be a number",
+		},
+		{
+			schema:        `{"properties": {"name": {"maxLength": 1.5}}}`,
+			expectedError: "name: maxLength must be a non-negative integer",
+		},
+		{
+			schema:        `{"properties": {"name": {"enum": "a"}}}`,
+			expectedError: "name: enum must be an array",
+		},
+		{
+			schema:        `{"properties": {"name": {"pattern": 1}}}`,
+			expectedError: "name: pattern must be a string",
+		},
+		{
+			schema:        `{"properties": {"tags": {"items": {"properties": {"name": {"type": "text"}}}}}}`,
+			expectedError: `tags.*.name: unknown type "text"`,
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			rules, unsupported, err := Import([]byte(tt.schema))
+
+			// then
+			require.EqualError(t, err, tt.expectedError)
+			require.Nil(t, rules)
+			require.Nil(t, unsupported)
+		})
+	}
+}
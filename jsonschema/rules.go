@@ -0,0 +1,242 @@
+package jsonschema
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/internal/engine"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Integer() *integerRule {
+	return &integerRule{}
+}
+
+type integerRule struct {
+	vr.Bailer
+}
+
+func (r *integerRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return value, nil
+	}
+
+	if number, ok := vr.ToFloat(v); !ok || number != math.Trunc(number) {
+		r.MarkBailed()
+
+		return value, vr.NewIntegerValidationError(TypeInteger, fmt.Sprintf("%T", v))
+	}
+
+	return value, nil
+}
+
+func (*integerRule) Describe() vr.RuleDescriptor {
+	return vr.RuleDescriptor{
+		Name: ve.RuleInt,
+		Params: map[string]any{
+			"type": TypeInteger,
+		},
+	}
+}
+
+func Number() *numberRule {
+	return &numberRule{}
+}
+
+type numberRule struct {
+	vr.Bailer
+}
+
+func (r *numberRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return value, nil
+	}
+
+	if _, ok := vr.ToFloat(v); !ok {
+		r.MarkBailed()
+
+		return value, vr.NewFloatValidationError(TypeNumber, fmt.Sprintf("%T", v))
+	}
+
+	return value, nil
+}
+
+func (*numberRule) Describe() vr.RuleDescriptor {
+	return vr.RuleDescriptor{
+		Name: ve.RuleFloat,
+		Params: map[string]any{
+			"type": TypeNumber,
+		},
+	}
+}
+
+func MinLength(length int) *minLengthRule {
+	return &minLengthRule{
+		length: length,
+	}
+}
+
+type minLengthRule struct {
+	length int
+}
+
+func (r *minLengthRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return value, nil
+	}
+
+	if s, ok := v.(string); ok && utf8.RuneCountInString(s) < r.length {
+		return value, vr.NewMinValidationError(ve.TypeString, r.length, true)
+	}
+
+	return value, nil
+}
+
+func (r *minLengthRule) Describe() vr.RuleDescriptor {
+	return vr.RuleDescriptor{
+		Name: ve.RuleMin,
+		Params: map[string]any{
+			"threshold": r.length,
+			"inclusive": true,
+		},
+	}
+}
+
+func MaxLength(length int) *maxLengthRule {
+	return &maxLengthRule{
+		length: length,
+	}
+}
+
+type maxLengthRule struct {
+	length int
+}
+
+func (r *maxLengthRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return value, nil
+	}
+
+	if s, ok := v.(string); ok && utf8.RuneCountInString(s) > r.length {
+		return value, vr.NewMaxValidationError(ve.TypeString, r.length, true)
+	}
+
+	return value, nil
+}
+
+func (r *maxLengthRule) Describe() vr.RuleDescriptor {
+	return vr.RuleDescriptor{
+		Name: ve.RuleMax,
+		Params: map[string]any{
+			"threshold": r.length,
+			"inclusive": true,
+		},
+	}
+}
+
+const RuleParentPresent = "PARENT_PRESENT"
+
+func WhenParentPresent(field string, rules ...vr.Rule) *parentPresentRule {
+	separator := strings.LastIndex(field, ".")
+
+	condition := isObject
+	if field[separator+1:] == "*" {
+		condition = isList
+	}
+
+	return &parentPresentRule{
+		parent:    field[:separator],
+		condition: condition,
+		rules:     rules,
+	}
+}
+
+type parentPresentRule struct {
+	parent    string
+	condition func(ctx context.Context, value any, data any) bool
+	rules     []vr.Rule
+}
+
+func (r *parentPresentRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	return value, nil
+}
+
+func (r *parentPresentRule) Rules(ctx context.Context, value any, data any) []vr.Rule {
+	if _, isNil := vr.Dereference(value); !isNil {
+		return r.rules
+	}
+
+	present := false
+
+	engine.WalkField(r.parent, data, func(parent engine.FieldValue) {
+		if !parent.Missing && r.condition(ctx, parent.Value, data) {
+			present = true
+		}
+	})
+
+	if !present {
+		return nil
+	}
+
+	return r.rules
+}
+
+func (r *parentPresentRule) Describe() vr.RuleDescriptor {
+	rules := make([]vr.RuleDescriptor, len(r.rules))
+	for idx, rule := range r.rules {
+		rules[idx] = vr.Describe(rule)
+	}
+
+	return vr.RuleDescriptor{
+		Name: RuleParentPresent,
+		Params: map[string]any{
+			"parent": r.parent,
+		},
+		Rules: rules,
+	}
+}
+
+func isNumber(_ context.Context, value any, _ any) bool {
+	v, _ := vr.Dereference(value)
+	_, ok := vr.ToFloat(v)
+
+	return ok
+}
+
+func isString(_ context.Context, value any, _ any) bool {
+	v, _ := vr.Dereference(value)
+	_, ok := v.(string)
+
+	return ok
+}
+
+func isList(_ context.Context, value any, _ any) bool {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return false
+	}
+
+	kind := reflect.TypeOf(v).Kind()
+
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+func isObject(_ context.Context, value any, _ any) bool {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return false
+	}
+
+	kind := reflect.TypeOf(v).Kind()
+
+	return kind == reflect.Map || kind == reflect.Struct
+}
@@ -0,0 +1,85 @@
+package jsonschema
+
+import (
+	"encoding/json"
+)
+
+const Draft202012 = "https://json-schema.org/draft/2020-12/schema"
+
+const (
+	TypeArray   = "array"
+	TypeBoolean = "boolean"
+	TypeInteger = "integer"
+	TypeNull    = "null"
+	TypeNumber  = "number"
+	TypeObject  = "object"
+	TypeString  = "string"
+)
+
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Description string `json:"description,omitempty"`
+	Default     any    `json:"default,omitempty"`
+
+	Type   Types     `json:"type,omitempty"`
+	Format string    `json:"format,omitempty"`
+	Enum   []any     `json:"enum,omitempty"`
+	AnyOf  []*Schema `json:"anyOf,omitempty"`
+	Not    *Schema   `json:"not,omitempty"`
+
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+
+	MinItems *int    `json:"minItems,omitempty"`
+	MaxItems *int    `json:"maxItems,omitempty"`
+	Items    *Schema `json:"items,omitempty"`
+
+	MinProperties *int               `json:"minProperties,omitempty"`
+	MaxProperties *int               `json:"maxProperties,omitempty"`
+	Required      []string           `json:"required,omitempty"`
+	Properties    map[string]*Schema `json:"properties,omitempty"`
+}
+
+type Types []string
+
+func (t Types) Has(typ string) bool {
+	for _, v := range t {
+		if v == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+
+	return json.Marshal([]string(t))
+}
+
+func (t *Types) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = Types{single}
+
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+
+	*t = multiple
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Types_JSON(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		types Types
+		json  string
+	}{
+		{types: Types{TypeString}, json: `"string"`},
+		{types: Types{TypeString, TypeNull}, json: `["string","null"]`},
+	} {
+		// when
+		marshalled, err := json.Marshal(tt.types)
+
+		// then
+		require.NoError(t, err, ttIdx)
+		require.Equal(t, tt.json, string(marshalled), ttIdx)
+
+		// and when
+		var unmarshalled Types
+		err = json.Unmarshal(marshalled, &unmarshalled)
+
+		// then
+		require.NoError(t, err, ttIdx)
+		require.Equal(t, tt.types, unmarshalled, ttIdx)
+	}
+}
+
+func Test_Types_UnmarshalJSON_FailsForInvalidType(t *testing.T) {
+	// given
+	var types Types
+
+	// when
+	err := json.Unmarshal([]byte(`123`), &types)
+
+	// then
+	require.Error(t, err)
+}
+
+func Test_Types_Has(t *testing.T) {
+	require.True(t, Types{TypeString, TypeNull}.Has(TypeNull))
+	require.False(t, Types{TypeString}.Has(TypeNull))
+	require.False(t, Types(nil).Has(TypeNull))
+}
@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type ObjectRule interface {
+	Apply(ctx context.Context, data any) ve.ErrorsBag
+}
+
+type ObjectRuleFunc func(ctx context.Context, data any) ve.ErrorsBag
+
+func (f ObjectRuleFunc) Apply(ctx context.Context, data any) ve.ErrorsBag {
+	return f(ctx, data)
+}
+
+func FieldValues(data any, field string) map[string]any {
+	values := map[string]any{}
+
+	for fieldValue := range newFieldsIterator(field, data) {
+		if !fieldValue.missing {
+			values[fieldValue.field] = fieldValue.value
+		}
+	}
+
+	return values
+}
+
+func applyObjectRules(ctx context.Context, data any, rules []ObjectRule, errorsBag ve.ErrorsBag) {
+	for _, rule := range rules {
+		for field, errors := range rule.Apply(ctx, data) {
+			errorsBag.Add(field, errors...)
+		}
+	}
+}
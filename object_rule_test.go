@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Test_ObjectRuleFunc(t *testing.T) {
+	// given
+	expectedErrorsBag := ve.ErrorsBag{
+		"field": {ve.NewCustomMessageValidationError("RULE", "message")},
+	}
+
+	rule := ObjectRuleFunc(func(_ context.Context, _ any) ve.ErrorsBag {
+		return expectedErrorsBag
+	})
+
+	// when
+	errorsBag := rule.Apply(context.TODO(), nil)
+
+	// then
+	require.Equal(t, expectedErrorsBag, errorsBag)
+}
+
+func Test_FieldValues(t *testing.T) {
+	// given
+	data := map[string]any{
+		"total": 30,
+		"items": []any{
+			map[string]any{"amount": 10},
+			map[string]any{"amount": nil},
+			map[string]any{},
+		},
+	}
+
+	for ttIdx, tt := range []struct {
+		field          string
+		expectedValues map[string]any
+	}{
+		{
+			field:          "total",
+			expectedValues: map[string]any{"total": 30},
+		},
+		{
+			field: "items.*.amount",
+			expectedValues: map[string]any{
+				"items.0.amount": 10,
+				"items.1.amount": nil,
+			},
+		},
+		{
+			field:          "missing",
+			expectedValues: map[string]any{},
+		},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			values := FieldValues(data, tt.field)
+
+			// then
+			require.Equal(t, tt.expectedValues, values)
+		})
+	}
+}
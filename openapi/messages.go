@@ -0,0 +1,164 @@
+package openapi
+
+import (
+	"strings"
+
+	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/jsonschema"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+const descriptionHeader = "Validation errors:"
+
+func describe(schema *jsonschema.Schema, rules []vr.Rule) string {
+	valueType := valueTypeOf(schema)
+
+	var messages []string
+
+	for _, rule := range rules {
+		describableRule, ok := rule.(vr.DescribableRule)
+		if !ok {
+			continue
+		}
+
+		if message, ok := ruleMessage(describableRule.Describe(), valueType); ok {
+			messages = append(messages, "- "+message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return ""
+	}
+
+	return descriptionHeader + "\n" + strings.Join(messages, "\n")
+}
+
+func ruleMessage(descriptor vr.RuleDescriptor, valueType string) (string, bool) {
+	var validationError ve.ValidationError
+
+	switch descriptor.Name {
+	case ve.RuleRequired:
+		validationError = vr.NewRequiredValidationError()
+
+	case ve.RuleFilled:
+		validationError = vr.NewFilledValidationError()
+
+	case ve.RuleString:
+		validationError = vr.NewStringValidationError()
+
+	case ve.RuleBoolean:
+		validationError = vr.NewBooleanValidationError()
+
+	case ve.RuleSlice:
+		validationError = vr.NewSliceValidationError()
+
+	case ve.RuleArray:
+		validationError = vr.NewArrayValidationError()
+
+	case ve.RuleMap:
+		validationError = vr.NewMapValidationError()
+
+	case ve.RuleStruct:
+		validationError = vr.NewStructValidationError()
+
+	case ve.RuleEmail:
+		validationError = vr.NewEmailValidationError()
+
+	case ve.RuleURL:
+		validationError = vr.NewUrlValidationError()
+
+	case ve.RuleUUID:
+		validationError = vr.NewUuidValidationError()
+
+	case ve.RuleIP:
+		validationError = vr.NewIpValidationError()
+
+	case ve.RuleRegex:
+		validationError = vr.NewRegexValidationError()
+
+	case ve.RuleNotRegex:
+		validationError = vr.NewNotRegexValidationError()
+
+	case ve.RuleDateFormat:
+		format, _ := descriptor.Params["format"].(string)
+		validationError = vr.NewDateFormatValidationError(format)
+
+	case ve.RuleIn:
+		validationError = vr.NewInValidationError(vr.ToSlice(descriptor.Params["values"]))
+
+	case ve.RuleNotIn:
+		validationError = vr.NewNotInValidationError(vr.ToSlice(descriptor.Params["values"]))
+
+	case ve.RuleMin, ve.RuleMax, ve.RuleBetween, ve.RuleLength:
+		if valueType == "" {
+			return "", false
+		}
+
+		validationError = boundError(descriptor, valueType)
+
+	default:
+		return "", false
+	}
+
+	return validationError.Error(), true
+}
+
+func boundError(descriptor vr.RuleDescriptor, valueType string) ve.ValidationError {
+	inclusive := descriptor.Params["inclusive"] == true
+
+	switch descriptor.Name {
+	case ve.RuleMin:
+		threshold, _ := vr.ToFloat(descriptor.Params["threshold"])
+
+		return vr.NewMinValidationError(valueType, threshold, inclusive)
+
+	case ve.RuleMax:
+		threshold, _ := vr.ToFloat(descriptor.Params["threshold"])
+
+		return vr.NewMaxValidationError(valueType, threshold, inclusive)
+
+	case ve.RuleBetween:
+		minimum, _ := vr.ToFloat(descriptor.Params["min"])
+		maximum, _ := vr.ToFloat(descriptor.Params["max"])
+
+		return vr.NewBetweenValidationError(valueType, minimum, maximum, inclusive)
+
+	default:
+		length, _ := vr.ToFloat(descriptor.Params["length"])
+
+		return vr.NewLengthValidationError(valueType, int(length))
+	}
+}
+
+func valueTypeOf(schema *jsonschema.Schema) string {
+	var valueType string
+
+	for _, typ := range schema.Type {
+		var candidate string
+
+		switch typ {
+		case jsonschema.TypeString:
+			candidate = ve.TypeString
+
+		case jsonschema.TypeInteger, jsonschema.TypeNumber:
+			candidate = ve.TypeNumber
+
+		case jsonschema.TypeArray:
+			candidate = ve.TypeSlice
+
+		case jsonschema.TypeObject:
+			candidate = ve.TypeMap
+
+		default:
+			continue
+		}
+
+		if valueType != "" && valueType != candidate {
+			return ""
+		}
+
+		valueType = candidate
+	}
+
+	return valueType
+}
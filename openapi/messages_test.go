@@ -0,0 +1,62 @@
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/donatorsky/go-validator/jsonschema"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_describe(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		types               jsonschema.Types
+		rule                vr.Rule
+		expectedDescription string
+	}{
+		{rule: vr.Required(), expectedDescription: "is required"},
+		{rule: vr.Filled(), expectedDescription: "must not be empty"},
+		{rule: vr.String(), expectedDescription: "must be a string"},
+		{rule: vr.Boolean(), expectedDescription: "must be true or false"},
+		{rule: vr.Slice(), expectedDescription: "must be a slice"},
+		{rule: vr.Array(), expectedDescription: "must be an array"},
+		{rule: vr.Map(), expectedDescription: "must be a map"},
+		{rule: vr.Struct(), expectedDescription: "must be a struct"},
+		{rule: vr.Email(), expectedDescription: "must be a valid email address"},
+		{rule: vr.URL(), expectedDescription: "must be a valid URL format"},
+		{rule: vr.UUID(), expectedDescription: "must be a valid UUID"},
+		{rule: vr.IP(), expectedDescription: "must be a valid IP address"},
+		{rule: vr.Regex(regexp.MustCompile(`a`)), expectedDescription: "format is invalid"},
+		{rule: vr.NotRegex(regexp.MustCompile(`a`)), expectedDescription: "format is invalid"},
+		{rule: vr.DateFormat(time.Kitchen), expectedDescription: "does not match the date format 3:04PM"},
+		{rule: vr.In([]int{1, 2}), expectedDescription: "does not exist in [1 2]"},
+		{rule: vr.NotIn([]string{"a"}), expectedDescription: "exists in [a]"},
+		{types: jsonschema.Types{jsonschema.TypeInteger}, rule: vr.MinExclusive(1.5), expectedDescription: "must be greater than 1.5"},
+		{types: jsonschema.Types{jsonschema.TypeString}, rule: vr.Max(10), expectedDescription: "must be at most 10 characters"},
+		{types: jsonschema.Types{jsonschema.TypeObject, jsonschema.TypeNull}, rule: vr.Length(2), expectedDescription: "must have exactly 2 items"},
+		{types: jsonschema.Types{jsonschema.TypeArray}, rule: vr.BetweenExclusive(1, 3), expectedDescription: "must have between 1 and 3 items (exclusive)"},
+		{rule: vr.Min(1)},
+		{types: jsonschema.Types{jsonschema.TypeString, jsonschema.TypeInteger}, rule: vr.Min(1)},
+		{rule: vr.Nullable()},
+		{rule: vr.Integer[int]()},
+		{rule: vr.Sometimes()},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// given
+			var expectedDescription string
+			if tt.expectedDescription != "" {
+				expectedDescription = descriptionHeader + "\n- " + tt.expectedDescription
+			}
+
+			// when
+			description := describe(&jsonschema.Schema{Type: tt.types}, []vr.Rule{tt.rule})
+
+			// then
+			require.Equal(t, expectedDescription, description)
+		})
+	}
+}
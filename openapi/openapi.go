@@ -0,0 +1,129 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	validator "github.com/donatorsky/go-validator"
+	"github.com/donatorsky/go-validator/jsonschema"
+)
+
+const (
+	InQuery = "query"
+
+	StyleForm       = "form"
+	StyleDeepObject = "deepObject"
+)
+
+type Parameter struct {
+	Name        string             `json:"name"`
+	In          string             `json:"in"`
+	Description string             `json:"description,omitempty"`
+	Required    bool               `json:"required,omitempty"`
+	Style       string             `json:"style,omitempty"`
+	Explode     bool               `json:"explode,omitempty"`
+	Schema      *jsonschema.Schema `json:"schema"`
+}
+
+func Schema(rules validator.RulesMap) (*jsonschema.Schema, []jsonschema.UnsupportedRule) {
+	schema, unsupported := jsonschema.Export(rules)
+	schema.Schema = ""
+
+	describeFields(schema, rules)
+
+	return schema, unsupported
+}
+
+func ComponentSchemas(schemas map[string]validator.RulesMap) (map[string]*jsonschema.Schema, []jsonschema.UnsupportedRule) {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	components := make(map[string]*jsonschema.Schema, len(schemas))
+
+	var unsupported []jsonschema.UnsupportedRule
+
+	for _, name := range names {
+		schema, schemaUnsupported := Schema(schemas[name])
+
+		for _, unsupportedRule := range schemaUnsupported {
+			unsupportedRule.Field = fmt.Sprintf("%s.%s", name, unsupportedRule.Field)
+			unsupported = append(unsupported, unsupportedRule)
+		}
+
+		components[name] = schema
+	}
+
+	return components, unsupported
+}
+
+func QueryParameters(rules validator.RulesMap) ([]Parameter, []jsonschema.UnsupportedRule) {
+	schema, unsupported := Schema(rules)
+
+	return parameters(schema), unsupported
+}
+
+func parameters(schema *jsonschema.Schema) []Parameter {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parameters := make([]Parameter, len(names))
+
+	for idx, name := range names {
+		property := *schema.Properties[name]
+
+		parameters[idx] = Parameter{
+			Name:        name,
+			In:          InQuery,
+			Description: property.Description,
+			Required:    contains(schema.Required, name),
+			Schema:      &property,
+		}
+
+		property.Description = ""
+
+		if property.Type.Has(jsonschema.TypeObject) {
+			parameters[idx].Style = StyleDeepObject
+			parameters[idx].Explode = true
+		} else if property.Type.Has(jsonschema.TypeArray) {
+			parameters[idx].Style = StyleForm
+			parameters[idx].Explode = true
+		}
+	}
+
+	return parameters
+}
+
+func describeFields(root *jsonschema.Schema, rules validator.RulesMap) {
+	for field, fieldRules := range rules {
+		schema := root
+
+		for _, part := range strings.Split(field, ".") {
+			if part == "*" {
+				schema = schema.Items
+			} else {
+				schema = schema.Properties[part]
+			}
+		}
+
+		schema.Description = describe(schema, fieldRules)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
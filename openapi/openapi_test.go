@@ -0,0 +1,178 @@
+package openapi
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	"github.com/donatorsky/go-validator/jsonschema"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_Schema(t *testing.T) {
+	// when
+	schema, unsupported := Schema(validator.RulesMap{
+		"name": {
+			vr.Required(),
+			vr.String(),
+			vr.Between(3, 64),
+		},
+		"role": {
+			vr.In([]string{"admin", "user"}),
+		},
+		"tags.*": {
+			vr.String(),
+			vr.Regex(regexp.MustCompile(`^[a-z]+$`)),
+		},
+		"score": {
+			vr.Min(3),
+			vr.Numeric(),
+		},
+	})
+
+	// then
+	require.Equal(t, []jsonschema.UnsupportedRule{
+		{Field: "score", Rule: "NUMERIC"},
+	}, unsupported)
+
+	actual, err := json.Marshal(schema)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {
+				"type": "string",
+				"minLength": 3,
+				"maxLength": 64,
+				"description": "Validation errors:\n- is required\n- must be a string\n- must be between 3 and 64 characters (inclusive)"
+			},
+			"role": {
+				"enum": ["admin", "user"],
+				"description": "Validation errors:\n- does not exist in [admin user]"
+			},
+			"score": {
+				"minimum": 3,
+				"minLength": 3,
+				"minItems": 3,
+				"minProperties": 3
+			},
+			"tags": {
+				"type": "array",
+				"items": {
+					"type": "string",
+					"pattern": "^[a-z]+$",
+					"description": "Validation errors:\n- must be a string\n- format is invalid"
+				}
+			}
+		}
+	}`, string(actual))
+}
+
+func Test_ComponentSchemas(t *testing.T) {
+	// when
+	schemas, unsupported := ComponentSchemas(map[string]validator.RulesMap{
+		"User": {
+			"email": {
+				vr.Required(),
+				vr.Email(),
+				vr.Numeric(),
+			},
+		},
+		"Address": {
+			"city": {
+				vr.String(),
+			},
+		},
+	})
+
+	// then
+	require.Equal(t, []jsonschema.UnsupportedRule{
+		{Field: "User.email", Rule: "NUMERIC"},
+	}, unsupported)
+
+	actual, err := json.Marshal(schemas)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"Address": {
+			"type": "object",
+			"properties": {
+				"city": {"type": "string", "description": "Validation errors:\n- must be a string"}
+			}
+		},
+		"User": {
+			"type": "object",
+			"required": ["email"],
+			"properties": {
+				"email": {"format": "email", "description": "Validation errors:\n- is required\n- must be a valid email address"}
+			}
+		}
+	}`, string(actual))
+}
+
+func Test_QueryParameters(t *testing.T) {
+	// when
+	parameters, unsupported := QueryParameters(validator.RulesMap{
+		"page": {
+			vr.Required(),
+			vr.Integer[int](),
+			vr.Min(1),
+		},
+		"ids": {
+			vr.Slice(),
+		},
+		"ids.*": {
+			vr.UUID(),
+		},
+		"filter.name": {
+			vr.String(),
+		},
+		"sort": {},
+	})
+
+	// then
+	require.Empty(t, unsupported)
+
+	actual, err := json.Marshal(parameters)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{
+			"name": "filter",
+			"in": "query",
+			"style": "deepObject",
+			"explode": true,
+			"schema": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "description": "Validation errors:\n- must be a string"}
+				}
+			}
+		},
+		{
+			"name": "ids",
+			"in": "query",
+			"description": "Validation errors:\n- must be a slice",
+			"style": "form",
+			"explode": true,
+			"schema": {
+				"type": "array",
+				"items": {"format": "uuid", "description": "Validation errors:\n- must be a valid UUID"}
+			}
+		},
+		{
+			"name": "page",
+			"in": "query",
+			"description": "Validation errors:\n- is required\n- must be at least 1",
+			"required": true,
+			"schema": {"type": "integer", "minimum": 1}
+		},
+		{
+			"name": "sort",
+			"in": "query",
+			"schema": {}
+		}
+	]`, string(actual))
+}
@@ -0,0 +1,235 @@
+package openapi
+
+import (
+	"reflect"
+	"time"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/jsonschema"
+)
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	validatableType = reflect.TypeOf((*validator.Validatable)(nil)).Elem()
+)
+
+func StructSchema(data any, rules validator.RulesMap) (*jsonschema.Schema, []jsonschema.UnsupportedRule, error) {
+	typeOf := reflect.TypeOf(data)
+	for typeOf != nil && typeOf.Kind() == reflect.Pointer {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf == nil || typeOf.Kind() != reflect.Struct {
+		return nil, nil, ve.NotStructTypeError{}
+	}
+
+	allRules := validator.RulesMap{}
+	collectRules(typeOf, "", allRules, map[reflect.Type]bool{})
+
+	for field, fieldRules := range rules {
+		allRules[field] = append(allRules[field], fieldRules...)
+	}
+
+	schema, unsupported := jsonschema.Export(allRules)
+	schema.Schema = ""
+
+	applyGoType(schema, typeOf, map[reflect.Type]bool{})
+	describeFields(schema, allRules)
+
+	return schema, unsupported, nil
+}
+
+func StructQueryParameters(data any, rules validator.RulesMap) ([]Parameter, []jsonschema.UnsupportedRule, error) {
+	schema, unsupported, err := StructSchema(data, rules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parameters(schema), unsupported, nil
+}
+
+func collectRules(typeOf reflect.Type, prefix string, rules validator.RulesMap, visited map[reflect.Type]bool) {
+	for typeOf.Kind() == reflect.Pointer {
+		typeOf = typeOf.Elem()
+	}
+
+	switch typeOf.Kind() {
+	case reflect.Slice, reflect.Array:
+		collectRules(typeOf.Elem(), joinField(prefix, "*"), rules, visited)
+
+		return
+
+	case reflect.Struct:
+
+	default:
+		return
+	}
+
+	if visited[typeOf] {
+		return
+	}
+
+	visited[typeOf] = true
+	defer delete(visited, typeOf)
+
+	if reflect.PointerTo(typeOf).Implements(validatableType) {
+		validatable := reflect.New(typeOf).Interface().(validator.Validatable)
+
+		for field, fieldRules := range validatable.ValidationRules() {
+			field = joinField(prefix, field)
+			rules[field] = append(rules[field], fieldRules...)
+		}
+	}
+
+	for _, structField := range structFields(typeOf) {
+		collectRules(structField.Type, joinField(prefix, fieldName(structField)), rules, visited)
+	}
+}
+
+func applyGoType(schema *jsonschema.Schema, typeOf reflect.Type, visited map[reflect.Type]bool) {
+	for typeOf.Kind() == reflect.Pointer {
+		typeOf = typeOf.Elem()
+	}
+
+	if len(schema.Type) == 0 {
+		if typ := goType(typeOf); typ != "" {
+			schema.Type = jsonschema.Types{typ}
+			pruneKeywords(schema, typ)
+		}
+	}
+
+	switch {
+	case typeOf == timeType:
+		if schema.Format == "" {
+			schema.Format = "date-time"
+		}
+
+	case typeOf.Kind() == reflect.Slice, typeOf.Kind() == reflect.Array:
+		if schema.Items == nil {
+			schema.Items = &jsonschema.Schema{}
+		}
+
+		applyGoType(schema.Items, typeOf.Elem(), visited)
+
+	case typeOf.Kind() == reflect.Struct:
+		if visited[typeOf] {
+			return
+		}
+
+		visited[typeOf] = true
+		defer delete(visited, typeOf)
+
+		for _, structField := range structFields(typeOf) {
+			name := fieldName(structField)
+
+			if schema.Properties == nil {
+				schema.Properties = map[string]*jsonschema.Schema{}
+			}
+
+			property := schema.Properties[name]
+			if property == nil {
+				property = &jsonschema.Schema{}
+				schema.Properties[name] = property
+			}
+
+			applyGoType(property, structField.Type, visited)
+
+			if structField.Type.Kind() == reflect.Pointer && len(property.Type) > 0 &&
+				!property.Type.Has(jsonschema.TypeNull) && !contains(schema.Required, name) {
+				property.Type = append(property.Type, jsonschema.TypeNull)
+			}
+		}
+	}
+}
+
+func goType(typeOf reflect.Type) string {
+	if typeOf == timeType {
+		return jsonschema.TypeString
+	}
+
+	switch typeOf.Kind() {
+	case reflect.String:
+		return jsonschema.TypeString
+
+	case reflect.Bool:
+		return jsonschema.TypeBoolean
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonschema.TypeInteger
+
+	case reflect.Float32, reflect.Float64:
+		return jsonschema.TypeNumber
+
+	case reflect.Slice, reflect.Array:
+		return jsonschema.TypeArray
+
+	case reflect.Map, reflect.Struct:
+		return jsonschema.TypeObject
+
+	default:
+		return ""
+	}
+}
+
+func pruneKeywords(schema *jsonschema.Schema, typ string) {
+	if typ != jsonschema.TypeInteger && typ != jsonschema.TypeNumber {
+		schema.Minimum, schema.Maximum, schema.ExclusiveMinimum, schema.ExclusiveMaximum = nil, nil, nil, nil
+	}
+
+	if typ != jsonschema.TypeString {
+		schema.MinLength, schema.MaxLength = nil, nil
+	}
+
+	if typ != jsonschema.TypeArray {
+		schema.MinItems, schema.MaxItems = nil, nil
+	}
+
+	if typ != jsonschema.TypeObject {
+		schema.MinProperties, schema.MaxProperties = nil, nil
+	}
+}
+
+func structFields(typeOf reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+
+	for idx := 0; idx < typeOf.NumField(); idx++ {
+		structField := typeOf.Field(idx)
+
+		if structField.Anonymous && structField.Tag.Get("validation") == "" {
+			embeddedType := structField.Type
+			for embeddedType.Kind() == reflect.Pointer {
+				embeddedType = embeddedType.Elem()
+			}
+
+			if embeddedType.Kind() == reflect.Struct {
+				fields = append(fields, structFields(embeddedType)...)
+
+				continue
+			}
+		}
+
+		if structField.IsExported() {
+			fields = append(fields, structField)
+		}
+	}
+
+	return fields
+}
+
+func fieldName(structField reflect.StructField) string {
+	if name := structField.Tag.Get("validation"); name != "" {
+		return name
+	}
+
+	return structField.Name
+}
+
+func joinField(prefix, field string) string {
+	if prefix == "" {
+		return field
+	}
+
+	return prefix + "." + field
+}
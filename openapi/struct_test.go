@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type structSchemaAddress struct {
+	City string `validation:"city"`
+	Zip  *string
+}
+
+func (*structSchemaAddress) ValidationRules() validator.RulesMap {
+	return validator.RulesMap{
+		"city": {
+			vr.Required(),
+			vr.Min(2),
+		},
+	}
+}
+
+type structSchemaTimestamps struct {
+	CreatedAt time.Time `validation:"created_at"`
+}
+
+type structSchemaUser struct {
+	structSchemaTimestamps
+
+	Name      string                `validation:"name"`
+	Age       *int                  `validation:"age"`
+	Nickname  *string               `validation:"nickname"`
+	Scores    []float64             `validation:"scores"`
+	Addresses []structSchemaAddress `validation:"addresses"`
+	Friend    *structSchemaUser     `validation:"friend"`
+	Meta      map[string]any
+	internal  string
+}
+
+func Test_StructSchema(t *testing.T) {
+	// when
+	schema, unsupported, err := StructSchema(&structSchemaUser{}, validator.RulesMap{
+		"name": {
+			vr.Required(),
+			vr.Between(3, 64),
+		},
+		"age": {
+			vr.Min(18),
+		},
+		"nickname": {
+			vr.Required(),
+		},
+		"scores": {
+			vr.Max(5),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Empty(t, unsupported)
+
+	actual, err := json.Marshal(schema)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "object",
+		"required": ["name", "nickname"],
+		"properties": {
+			"created_at": {"type": "string", "format": "date-time"},
+			"name": {
+				"type": "string",
+				"minLength": 3,
+				"maxLength": 64,
+				"description": "Validation errors:\n- is required\n- must be between 3 and 64 characters (inclusive)"
+			},
+			"age": {
+				"type": ["integer", "null"],
+				"minimum": 18,
+				"description": "Validation errors:\n- must be at least 18"
+			},
+			"nickname": {"type": "string", "description": "Validation errors:\n- is required"},
+			"scores": {
+				"type": "array",
+				"maxItems": 5,
+				"items": {"type": "number"},
+				"description": "Validation errors:\n- must have at most 5 items"
+			},
+			"addresses": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"required": ["city"],
+					"properties": {
+						"city": {
+							"type": "string",
+							"minLength": 2,
+							"description": "Validation errors:\n- is required\n- must be at least 2 characters"
+						},
+						"Zip": {"type": ["string", "null"]}
+					}
+				}
+			},
+			"friend": {"type": ["object", "null"]},
+			"Meta": {"type": "object"}
+		}
+	}`, string(actual))
+}
+
+func Test_StructSchema_FailsForNonStruct(t *testing.T) {
+	for _, data := range []any{nil, "foo", new(int), []structSchemaUser{}} {
+		// when
+		schema, unsupported, err := StructSchema(data, nil)
+
+		// then
+		require.ErrorIs(t, err, ve.NotStructTypeError{})
+		require.Nil(t, schema)
+		require.Nil(t, unsupported)
+	}
+}
+
+func Test_StructQueryParameters(t *testing.T) {
+	// given
+	type query struct {
+		Page  int    `validation:"page"`
+		Query string `validation:"q"`
+	}
+
+	// when
+	parameters, unsupported, err := StructQueryParameters(query{}, validator.RulesMap{
+		"page": {
+			vr.Required(),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Empty(t, unsupported)
+
+	actual, err := json.Marshal(parameters)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{
+			"name": "page",
+			"in": "query",
+			"description": "Validation errors:\n- is required",
+			"required": true,
+			"schema": {"type": "integer"}
+		},
+		{
+			"name": "q",
+			"in": "query",
+			"schema": {"type": "string"}
+		}
+	]`, string(actual))
+
+	// when
+	parameters, unsupported, err = StructQueryParameters(1, nil)
+
+	// then
+	require.ErrorIs(t, err, ve.NotStructTypeError{})
+	require.Nil(t, parameters)
+	require.Nil(t, unsupported)
+}
@@ -34,6 +34,15 @@ func (r afterRule) Apply(_ context.Context, value any, _ any) (any, ve.Validatio
 	return value, nil
 }
 
+func (r *afterRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleAfter,
+		Params: map[string]any{
+			"time": r.after,
+		},
+	}
+}
+
 func NewAfterValidationError(after string) AfterValidationError {
 	return AfterValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -35,6 +35,15 @@ func (r afterOrEqualRule) Apply(_ context.Context, value any, _ any) (any, ve.Va
 	return value, nil
 }
 
+func (r *afterOrEqualRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleAfterOrEqual,
+		Params: map[string]any{
+			"time": r.afterOrEqual,
+		},
+	}
+}
+
 func NewAfterOrEqualValidationError(afterOrEqual string) AfterOrEqualValidationError {
 	return AfterOrEqualValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -0,0 +1,81 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func AllOf(ruleSets ...[]Rule) *allOfRule {
+	return &allOfRule{
+		ruleSets: ruleSets,
+	}
+}
+
+type allOfRule struct {
+	ruleSets [][]Rule
+}
+
+func (r *allOfRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *allOfRule) ApplyWithPresence(ctx context.Context, value any, present bool, data any) (any, ve.ValidationError) {
+	var (
+		ruleSetsErrors   = make([][]ve.ValidationError, len(r.ruleSets))
+		ruleSetsNotices  []ve.ValidationError
+		anyRuleSetFailed bool
+		passedValue      = value
+	)
+
+	for idx, rules := range r.ruleSets {
+		newValue, failures, notices := applyRules(ctx, passedValue, present, data, rules)
+		if len(failures) > 0 {
+			ruleSetsErrors[idx] = failures
+			anyRuleSetFailed = true
+
+			continue
+		}
+
+		passedValue = newValue
+
+		ruleSetsNotices = append(ruleSetsNotices, notices)
+	}
+
+	if anyRuleSetFailed {
+		return value, NewAllOfValidationError(ruleSetsErrors)
+	}
+
+	return passedValue, ve.Join(ruleSetsNotices...)
+}
+
+func (r *allOfRule) Combines() bool {
+	return true
+}
+
+func (r *allOfRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:     ve.RuleAllOf,
+		RuleSets: describeRuleSets(r.ruleSets),
+	}
+}
+
+func NewAllOfValidationError(ruleSetsErrors [][]ve.ValidationError) AllOfValidationError {
+	return AllOfValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RuleAllOf,
+		},
+		Errors: ruleSetsErrors,
+	}
+}
+
+type AllOfValidationError struct {
+	ve.BasicValidationError
+
+	Errors [][]ve.ValidationError `json:"errors"`
+}
+
+func (e AllOfValidationError) Error() string {
+	return fmt.Sprintf("must pass all rule sets: %s", formatRuleSetsErrors(e.Errors))
+}
@@ -0,0 +1,90 @@
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Test_AllOfRule(t *testing.T) {
+	runRuleTestCases(t, allOfRuleDataProvider)
+}
+
+func Test_AllOfValidationError(t *testing.T) {
+	// when
+	err := NewAllOfValidationError([][]ve.ValidationError{
+		nil,
+		{NewNumericValidationError()},
+	})
+
+	// then
+	require.EqualError(t, err, "must pass all rule sets: #1: {must be a number}")
+}
+
+func BenchmarkAllOfRule(b *testing.B) {
+	runRuleBenchmarks(b, allOfRuleDataProvider)
+}
+
+func allOfRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             AllOf([]Rule{String()}, []Rule{Numeric()}),
+			value:            nil,
+			expectedNewValue: (*string)(nil),
+			expectedError:    nil,
+		},
+		"no rule sets": {
+			rule:             AllOf(),
+			value:            "123",
+			expectedNewValue: "123",
+			expectedError:    nil,
+		},
+
+		"all rule sets pass, value is not modified": {
+			rule:             AllOf([]Rule{String()}, []Rule{Min(1)}),
+			value:            "123",
+			expectedNewValue: "123",
+			expectedError:    nil,
+		},
+		"all rule sets pass, value is modified": {
+			rule:             AllOf([]Rule{Numeric()}),
+			value:            "12",
+			expectedNewValue: int64(12),
+			expectedError:    nil,
+		},
+		"all rule sets pass, modified value is passed to next rule sets": {
+			rule:             AllOf([]Rule{Numeric()}, []Rule{Integer[int64]()}, []Rule{Min(10)}),
+			value:            "12",
+			expectedNewValue: int64(12),
+			expectedError:    nil,
+		},
+		"some rule sets fail": {
+			rule:             AllOf([]Rule{String()}, []Rule{Numeric(), Min(200)}, []Rule{Integer[int]()}),
+			value:            "123",
+			expectedNewValue: "123",
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAllOfValidationError([][]ve.ValidationError{
+					nil,
+					{NewMinValidationError(ve.TypeNumber, 200, true)},
+					{NewIntegerValidationError("int", "string")},
+				}), err)
+			},
+		},
+		"rule set returns multiple errors": {
+			rule: AllOf([]Rule{Custom(func(_ context.Context, value string, _ any) (string, error) {
+				return value, ve.Join(NewNumericValidationError(), NewMinValidationError(ve.TypeString, 5, true))
+			})}),
+			value:            "abc",
+			expectedNewValue: "abc",
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAllOfValidationError([][]ve.ValidationError{
+					{NewNumericValidationError(), NewMinValidationError(ve.TypeString, 5, true)},
+				}), err)
+			},
+		},
+	}
+}
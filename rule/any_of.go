@@ -0,0 +1,67 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func AnyOf(ruleSets ...[]Rule) *anyOfRule {
+	return &anyOfRule{
+		ruleSets: ruleSets,
+	}
+}
+
+type anyOfRule struct {
+	ruleSets [][]Rule
+}
+
+func (r *anyOfRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *anyOfRule) ApplyWithPresence(ctx context.Context, value any, present bool, data any) (any, ve.ValidationError) {
+	ruleSetsErrors := make([][]ve.ValidationError, len(r.ruleSets))
+
+	for idx, rules := range r.ruleSets {
+		newValue, failures, notices := applyRules(ctx, value, present, data, rules)
+		if len(failures) == 0 {
+			return newValue, notices
+		}
+
+		ruleSetsErrors[idx] = failures
+	}
+
+	return value, NewAnyOfValidationError(ruleSetsErrors)
+}
+
+func (r *anyOfRule) Combines() bool {
+	return true
+}
+
+func (r *anyOfRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:     ve.RuleAnyOf,
+		RuleSets: describeRuleSets(r.ruleSets),
+	}
+}
+
+func NewAnyOfValidationError(ruleSetsErrors [][]ve.ValidationError) AnyOfValidationError {
+	return AnyOfValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RuleAnyOf,
+		},
+		Errors: ruleSetsErrors,
+	}
+}
+
+type AnyOfValidationError struct {
+	ve.BasicValidationError
+
+	Errors [][]ve.ValidationError `json:"errors"`
+}
+
+func (e AnyOfValidationError) Error() string {
+	return fmt.Sprintf("must pass at least one of rule sets: %s", formatRuleSetsErrors(e.Errors))
+}
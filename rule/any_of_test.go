@@ -0,0 +1,122 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Test_AnyOfRule(t *testing.T) {
+	runRuleTestCases(t, anyOfRuleDataProvider)
+}
+
+func Test_AnyOfValidationError(t *testing.T) {
+	// when
+	err := NewAnyOfValidationError([][]ve.ValidationError{
+		{NewUuidValidationError()},
+		{NewNumericValidationError(), NewMinValidationError(ve.TypeNumber, 1, true)},
+	})
+
+	// then
+	require.EqualError(t, err, "must pass at least one of rule sets: #0: {must be a valid UUID}, #1: {must be a number; must be at least 1}")
+}
+
+func BenchmarkAnyOfRule(b *testing.B) {
+	runRuleBenchmarks(b, anyOfRuleDataProvider)
+}
+
+func anyOfRuleDataProvider() map[string]*ruleTestCaseData {
+	var uuidDummy = fakerInstance.UUID().V4()
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             AnyOf([]Rule{UUID()}, []Rule{Numeric()}),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+		"no rule sets": {
+			rule:             AnyOf(),
+			value:            uuidDummy,
+			expectedNewValue: uuidDummy,
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAnyOfValidationError([][]ve.ValidationError{}), err)
+			},
+		},
+
+		"first rule set passes": {
+			rule:             AnyOf([]Rule{Required(), UUID()}, []Rule{Numeric()}),
+			value:            uuidDummy,
+			expectedNewValue: uuidDummy,
+			expectedError:    nil,
+		},
+		"second rule set passes with transformed value": {
+			rule:             AnyOf([]Rule{Required(), UUID()}, []Rule{Numeric(), Min(1)}),
+			value:            "123",
+			expectedNewValue: int64(123),
+			expectedError:    nil,
+		},
+		"no rule set passes": {
+			rule:             AnyOf([]Rule{Required(), UUID()}, []Rule{Numeric(), Min(1)}),
+			value:            "-1",
+			expectedNewValue: "-1",
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAnyOfValidationError([][]ve.ValidationError{
+					{NewUuidValidationError()},
+					{NewMinValidationError(ve.TypeNumber, 1, true)},
+				}), err)
+			},
+		},
+		"rule set with element rules fails": {
+			rule:             AnyOf([]Rule{Each(Integer[int]())}, []Rule{String()}),
+			value:            []any{"x", 1},
+			expectedNewValue: []any{"x", 1},
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAnyOfValidationError([][]ve.ValidationError{
+					{ve.NewFieldValidationError("0", NewIntegerValidationError("int", "string"))},
+					{NewStringValidationError()},
+				}), err)
+			},
+		},
+		"rule set with element rules passes": {
+			rule:             AnyOf([]Rule{String()}, []Rule{Each(Integer[int]())}),
+			value:            []any{1, 2},
+			expectedNewValue: []any{1, 2},
+			expectedError:    nil,
+		},
+		"rule set with nested rules fails": {
+			rule:             AnyOf([]Rule{Nested(map[string][]Rule{"foo": {Required()}})}),
+			value:            map[string]any{},
+			expectedNewValue: map[string]any{},
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAnyOfValidationError([][]ve.ValidationError{
+					{ve.NewFieldValidationError("foo", NewRequiredValidationError())},
+				}), err)
+			},
+		},
+		"bailing rule stops only its rule set": {
+			rule:             AnyOf([]Rule{Required(), UUID()}, []Rule{Required(), Bail(), Numeric()}),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAnyOfValidationError([][]ve.ValidationError{
+					{NewRequiredValidationError()},
+					{NewRequiredValidationError()},
+				}), err)
+			},
+		},
+		"conditional rules are expanded": {
+			rule:             AnyOf([]Rule{When(true, Numeric())}),
+			value:            "abc",
+			expectedNewValue: "abc",
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewAnyOfValidationError([][]ve.ValidationError{
+					{NewNumericValidationError()},
+				}), err)
+			},
+		},
+	}
+}
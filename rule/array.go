@@ -30,6 +30,12 @@ func (r *arrayRule) Apply(_ context.Context, value any, _ any) (any, ve.Validati
 	return value, nil
 }
 
+func (*arrayRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleArray,
+	}
+}
+
 func NewArrayValidationError() ArrayValidationError {
 	return ArrayValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -36,6 +36,15 @@ func (r *arrayOfRule[Out]) Apply(_ context.Context, value any, _ any) (any, ve.V
 	return value, nil
 }
 
+func (*arrayOfRule[Out]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleArrayOf,
+		Params: map[string]any{
+			"type": fmt.Sprintf("%T", *new(Out)),
+		},
+	}
+}
+
 func NewArrayOfValidationError(expected, actual string) ArrayOfValidationError {
 	return ArrayOfValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -20,3 +20,9 @@ func (*bailRule) Apply(_ context.Context, _ any, _ any) (any, ve.ValidationError
 func (*bailRule) Bails() bool {
 	return true
 }
+
+func (*bailRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleBail,
+	}
+}
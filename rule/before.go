@@ -34,6 +34,15 @@ func (r beforeRule) Apply(_ context.Context, value any, _ any) (any, ve.Validati
 	return value, nil
 }
 
+func (r *beforeRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleBefore,
+		Params: map[string]any{
+			"time": r.before,
+		},
+	}
+}
+
 func NewBeforeValidationError(before string) BeforeValidationError {
 	return BeforeValidationError{
 		BasicValidationError: ve.BasicValidationError{
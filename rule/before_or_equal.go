@@ -35,6 +35,15 @@ func (r beforeOrEqualRule) Apply(_ context.Context, value any, _ any) (any, ve.V
 	return value, nil
 }
 
+func (r *beforeOrEqualRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleBeforeOrEqual,
+		Params: map[string]any{
+			"time": r.beforeOrEqual,
+		},
+	}
+}
+
 func NewBeforeOrEqualValidationError(beforeOrEqual string) BeforeOrEqualValidationError {
 	return BeforeOrEqualValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -119,6 +119,17 @@ func (r *betweenRule[T]) Apply(_ context.Context, value any, _ any) (any, ve.Val
 	return value, nil
 }
 
+func (r *betweenRule[T]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleBetween,
+		Params: map[string]any{
+			"min":       r.min,
+			"max":       r.max,
+			"inclusive": r.inclusive,
+		},
+	}
+}
+
 func isBetween[V, T numberType](v V, min, max T, inclusive bool) bool {
 	if inclusive {
 		// v >= min && v <= max
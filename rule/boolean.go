@@ -150,6 +150,12 @@ func (r *booleanRule) Apply(_ context.Context, value any, _ any) (any, ve.Valida
 	}
 }
 
+func (*booleanRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleBoolean,
+	}
+}
+
 func NewBooleanValidationError() BooleanValidationError {
 	return BooleanValidationError{
 		BasicValidationError: ve.BasicValidationError{
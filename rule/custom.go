@@ -37,6 +37,16 @@ func (r customRule[In, Out]) Apply(ctx context.Context, value any, data any) (an
 	return outValue, nil
 }
 
+func (customRule[In, Out]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleCustom,
+		Params: map[string]any{
+			"in":  fmt.Sprintf("%T", *new(In)),
+			"out": fmt.Sprintf("%T", *new(Out)),
+		},
+	}
+}
+
 func NewCustomValidationError(err error) CustomValidationError {
 	return CustomValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -41,6 +41,15 @@ func (r dateFormatRule) Apply(_ context.Context, value any, _ any) (any, ve.Vali
 	}
 }
 
+func (r *dateFormatRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleDateFormat,
+		Params: map[string]any{
+			"format": r.format,
+		},
+	}
+}
+
 func NewDateFormatValidationError(format string) DateFormatValidationError {
 	return DateFormatValidationError{
 		BasicValidationError: ve.BasicValidationError{
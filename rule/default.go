@@ -0,0 +1,13 @@
+package rule
+
+import (
+	"context"
+)
+
+func Default(value any) *defaultFuncRule {
+	return &defaultFuncRule{
+		valueProvider: func(_ context.Context, _ any) any { return value },
+		value:         value,
+		constant:      true,
+	}
+}
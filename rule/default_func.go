@@ -0,0 +1,43 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type defaultValueProvider func(ctx context.Context, data any) any
+
+func DefaultFunc(valueProvider defaultValueProvider) *defaultFuncRule {
+	return &defaultFuncRule{
+		valueProvider: valueProvider,
+	}
+}
+
+type defaultFuncRule struct {
+	valueProvider defaultValueProvider
+	value         any
+	constant      bool
+}
+
+func (r *defaultFuncRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	if _, isNil := Dereference(value); isNil {
+		return r.valueProvider(ctx, data), nil
+	}
+
+	return value, nil
+}
+
+func (r *defaultFuncRule) Describe() RuleDescriptor {
+	var params map[string]any
+	if r.constant {
+		params = map[string]any{
+			"value": r.value,
+		}
+	}
+
+	return RuleDescriptor{
+		Name:   ve.RuleDefault,
+		Params: params,
+	}
+}
@@ -0,0 +1,59 @@
+package rule
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_DefaultFuncRule(t *testing.T) {
+	runRuleTestCases(t, defaultFuncRuleDataProvider)
+}
+
+func BenchmarkDefaultFuncRule(b *testing.B) {
+	runRuleBenchmarks(b, defaultFuncRuleDataProvider)
+}
+
+func defaultFuncRuleDataProvider() map[string]*ruleTestCaseData {
+	var (
+		intDummy  = fakerInstance.Int()
+		dataDummy = map[string]any{
+			"default": fakerInstance.Int(),
+		}
+	)
+
+	valueProvider := func(_ context.Context, data any) any {
+		return data.(map[string]any)["default"]
+	}
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             DefaultFunc(valueProvider),
+			value:            nil,
+			data:             dataDummy,
+			expectedNewValue: dataDummy["default"],
+			expectedError:    nil,
+		},
+		"pointer to int nil pointer": {
+			rule:             DefaultFunc(valueProvider),
+			value:            (*int)(nil),
+			data:             dataDummy,
+			expectedNewValue: dataDummy["default"],
+			expectedError:    nil,
+		},
+
+		"int": {
+			rule:             DefaultFunc(valueProvider),
+			value:            intDummy,
+			data:             dataDummy,
+			expectedNewValue: intDummy,
+			expectedError:    nil,
+		},
+		"pointer to int": {
+			rule:             DefaultFunc(valueProvider),
+			value:            &intDummy,
+			data:             dataDummy,
+			expectedNewValue: &intDummy,
+			expectedError:    nil,
+		},
+	}
+}
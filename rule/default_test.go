@@ -0,0 +1,54 @@
+package rule
+
+import (
+	"testing"
+)
+
+func Test_DefaultRule(t *testing.T) {
+	runRuleTestCases(t, defaultRuleDataProvider)
+}
+
+func BenchmarkDefaultRule(b *testing.B) {
+	runRuleBenchmarks(b, defaultRuleDataProvider)
+}
+
+func defaultRuleDataProvider() map[string]*ruleTestCaseData {
+	var (
+		stringDummy  = fakerInstance.Lorem().Sentence(6)
+		defaultDummy = fakerInstance.Lorem().Sentence(6)
+	)
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Default(defaultDummy),
+			value:            nil,
+			expectedNewValue: defaultDummy,
+			expectedError:    nil,
+		},
+		"pointer to string nil pointer": {
+			rule:             Default(defaultDummy),
+			value:            (*string)(nil),
+			expectedNewValue: defaultDummy,
+			expectedError:    nil,
+		},
+
+		"string": {
+			rule:             Default(defaultDummy),
+			value:            stringDummy,
+			expectedNewValue: stringDummy,
+			expectedError:    nil,
+		},
+		"empty string": {
+			rule:             Default(defaultDummy),
+			value:            "",
+			expectedNewValue: "",
+			expectedError:    nil,
+		},
+		"pointer to string": {
+			rule:             Default(defaultDummy),
+			value:            &stringDummy,
+			expectedNewValue: &stringDummy,
+			expectedError:    nil,
+		},
+	}
+}
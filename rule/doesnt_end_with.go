@@ -43,6 +43,15 @@ func (r *doesntEndWithRule) Apply(_ context.Context, value any, _ any) (any, ve.
 	return value, nil
 }
 
+func (r *doesntEndWithRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleDoesntEndWith,
+		Params: map[string]any{
+			"suffixes": r.suffixes,
+		},
+	}
+}
+
 func NewDoesntEndWithValidationError(suffixes []string) DoesntEndWithValidationError {
 	return DoesntEndWithValidationError{
 		BasicValidationError: ve.BasicValidationError{
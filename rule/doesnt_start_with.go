@@ -43,6 +43,15 @@ func (r *doesntStartWithRule) Apply(_ context.Context, value any, _ any) (any, v
 	return value, nil
 }
 
+func (r *doesntStartWithRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleDoesntStartWith,
+		Params: map[string]any{
+			"prefixes": r.prefixes,
+		},
+	}
+}
+
 func NewDoesntStartWithValidationError(prefixes []string) DoesntStartWithValidationError {
 	return DoesntStartWithValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -37,6 +37,12 @@ func (*durationRule) Apply(_ context.Context, value any, _ any) (any, ve.Validat
 	}
 }
 
+func (*durationRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleDuration,
+	}
+}
+
 func NewDurationValidationError() DurationValidationError {
 	return DurationValidationError{
 		BasicValidationError: ve.BasicValidationError{
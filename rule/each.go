@@ -0,0 +1,106 @@
+package rule
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+
+	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/internal/engine"
+)
+
+func Each(rules ...Rule) *eachRule {
+	return &eachRule{
+		rules: rules,
+	}
+}
+
+type eachRule struct {
+	rules []Rule
+}
+
+func (*eachRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	return value, nil
+}
+
+func (r *eachRule) Elements(_ context.Context, value any, _ any) []engine.Target[Rule] {
+	v, isNil := Dereference(value)
+	if isNil {
+		return nil
+	}
+
+	switch valueOf := reflect.ValueOf(v); valueOf.Kind() {
+	case reflect.Slice, reflect.Array:
+		targets := make([]engine.Target[Rule], valueOf.Len())
+
+		for idx := range targets {
+			targets[idx] = r.newTarget(strconv.Itoa(idx), valueOf.Index(idx))
+		}
+
+		return targets
+
+	case reflect.Map:
+		targets := make([]engine.Target[Rule], 0, valueOf.Len())
+
+		for _, key := range valueOf.MapKeys() {
+			if field, ok := mapKeyToField(key); ok {
+				targets = append(targets, r.newTarget(field, valueOf.MapIndex(key)))
+			}
+		}
+
+		sort.Slice(targets, func(i, j int) bool {
+			return targets[i].Field < targets[j].Field
+		})
+
+		return targets
+
+	default:
+		return nil
+	}
+}
+
+func (r *eachRule) newTarget(field string, element reflect.Value) engine.Target[Rule] {
+	value, isNil := Dereference(element.Interface())
+	if isNil {
+		value = nil
+	}
+
+	return engine.Target[Rule]{
+		FieldValue: engine.FieldValue{
+			Field: field,
+			Value: value,
+		},
+		Rules: r.rules,
+	}
+}
+
+func (r *eachRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:  ve.RuleEach,
+		Rules: describeRules(r.rules),
+	}
+}
+
+func mapKeyToField(key reflect.Value) (string, bool) {
+	switch key.Kind() {
+	case reflect.String:
+		return key.String(), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(key.Uint(), 10), true
+
+	case reflect.Interface:
+		if key.Elem().Kind() != reflect.String {
+			return "", false
+		}
+
+		return key.Elem().String(), true
+
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,114 @@
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/donatorsky/go-validator/internal/engine"
+)
+
+func Test_EachRule(t *testing.T) {
+	runRuleTestCases(t, eachRuleDataProvider)
+}
+
+func BenchmarkEachRule(b *testing.B) {
+	runRuleBenchmarks(b, eachRuleDataProvider)
+}
+
+func Test_Each_Elements(t *testing.T) {
+	// given
+	var (
+		rules   = []Rule{newRuleMock(0), newRuleMock(1)}
+		pointer = 1
+	)
+
+	newTarget := func(field string, value any) engine.Target[Rule] {
+		return engine.Target[Rule]{
+			FieldValue: engine.FieldValue{Field: field, Value: value},
+			Rules:      rules,
+		}
+	}
+
+	for ttName, tt := range map[string]struct {
+		value           any
+		expectedTargets []engine.Target[Rule]
+	}{
+		"nil": {
+			value:           nil,
+			expectedTargets: nil,
+		},
+		"nil slice": {
+			value:           ([]int)(nil),
+			expectedTargets: nil,
+		},
+		"string": {
+			value:           "foo",
+			expectedTargets: nil,
+		},
+		"slice": {
+			value:           []int{1, 2},
+			expectedTargets: []engine.Target[Rule]{newTarget("0", 1), newTarget("1", 2)},
+		},
+		"slice with pointers": {
+			value:           []*int{&pointer, nil},
+			expectedTargets: []engine.Target[Rule]{newTarget("0", 1), newTarget("1", nil)},
+		},
+		"pointer to array": {
+			value:           &[2]int{1, 2},
+			expectedTargets: []engine.Target[Rule]{newTarget("0", 1), newTarget("1", 2)},
+		},
+		"map with string keys": {
+			value:           map[string]int{"foo": 1, "bar": 2},
+			expectedTargets: []engine.Target[Rule]{newTarget("bar", 2), newTarget("foo", 1)},
+		},
+		"map with keys containing path characters": {
+			value:           map[string]int{"a.b": 1, "*": 2},
+			expectedTargets: []engine.Target[Rule]{newTarget("*", 2), newTarget("a.b", 1)},
+		},
+		"map with integer keys": {
+			value:           map[int]int{-1: 1, 2: 2},
+			expectedTargets: []engine.Target[Rule]{newTarget("-1", 1), newTarget("2", 2)},
+		},
+		"map with unsigned integer keys": {
+			value:           map[uint8]int{1: 1},
+			expectedTargets: []engine.Target[Rule]{newTarget("1", 1)},
+		},
+		"map with any keys": {
+			value:           map[any]int{"foo": 1, 2: 2},
+			expectedTargets: []engine.Target[Rule]{newTarget("foo", 1)},
+		},
+		"map with unsupported keys": {
+			value:           map[float64]int{1.5: 1},
+			expectedTargets: []engine.Target[Rule]{},
+		},
+	} {
+		t.Run(ttName, func(t *testing.T) {
+			// when
+			targets := Each(rules...).Elements(context.Background(), tt.value, nil)
+
+			// then
+			require.Equal(t, tt.expectedTargets, targets)
+		})
+	}
+}
+
+func eachRuleDataProvider() map[string]*ruleTestCaseData {
+	var sliceDummy = []string{fakerInstance.Lorem().Word()}
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Each(),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+		"slice": {
+			rule:             Each(),
+			value:            sliceDummy,
+			expectedNewValue: sliceDummy,
+			expectedError:    nil,
+		},
+	}
+}
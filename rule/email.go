@@ -33,6 +33,12 @@ func (*emailRule) Apply(_ context.Context, value any, _ any) (any, ve.Validation
 	return value, nil
 }
 
+func (*emailRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleEmail,
+	}
+}
+
 func NewEmailValidationError() EmailValidationError {
 	return EmailValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -32,3 +32,9 @@ func (*emailAddressRule) Apply(_ context.Context, value any, _ any) (any, ve.Val
 
 	return email.Address, nil
 }
+
+func (*emailAddressRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleEmail,
+	}
+}
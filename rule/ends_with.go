@@ -43,6 +43,15 @@ func (r *endsWithRule) Apply(_ context.Context, value any, _ any) (any, ve.Valid
 	return value, NewEndsWithValidationError(r.suffixes)
 }
 
+func (r *endsWithRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleEndsWith,
+		Params: map[string]any{
+			"suffixes": r.suffixes,
+		},
+	}
+}
+
 func NewEndsWithValidationError(suffixes []string) EndsWithValidationError {
 	return EndsWithValidationError{
 		BasicValidationError: ve.BasicValidationError{
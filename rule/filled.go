@@ -34,6 +34,12 @@ func (r *filledRule) Apply(ctx context.Context, value any, data any) (any, ve.Va
 	return value, nil
 }
 
+func (*filledRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleFilled,
+	}
+}
+
 func NewFilledValidationError() FilledValidationError {
 	return FilledValidationError{
 		BasicValidationError: ve.BasicValidationError{
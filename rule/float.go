@@ -33,6 +33,15 @@ func (r *floatRule[Out]) Apply(_ context.Context, value any, _ any) (any, ve.Val
 	return value, nil
 }
 
+func (*floatRule[Out]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleFloat,
+		Params: map[string]any{
+			"type": fmt.Sprintf("%T", *new(Out)),
+		},
+	}
+}
+
 func NewFloatValidationError(expectedType, actualType string) FloatValidationError {
 	return FloatValidationError{
 		BasicValidationError: ve.BasicValidationError{
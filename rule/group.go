@@ -0,0 +1,65 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type groupsContextKey struct{}
+
+func ContextWithGroups(ctx context.Context, groups ...string) context.Context {
+	return context.WithValue(ctx, groupsContextKey{}, groups)
+}
+
+func GroupsFromContext(ctx context.Context) []string {
+	groups, _ := ctx.Value(groupsContextKey{}).([]string)
+
+	return groups
+}
+
+func IsAnyGroupActive(activeGroups []string, groups []string) bool {
+	for _, group := range groups {
+		for _, activeGroup := range activeGroups {
+			if group == activeGroup {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func Group(groups []string, rules ...Rule) *groupRule {
+	return &groupRule{
+		groups: groups,
+		rules:  rules,
+	}
+}
+
+type groupRule struct {
+	groups []string
+	rules  []Rule
+}
+
+func (r *groupRule) Apply(_ context.Context, _ any, _ any) (any, ve.ValidationError) {
+	return nil, nil
+}
+
+func (r *groupRule) Rules(ctx context.Context, _ any, _ any) []Rule {
+	if !IsAnyGroupActive(GroupsFromContext(ctx), r.groups) {
+		return nil
+	}
+
+	return r.rules
+}
+
+func (r *groupRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleGroup,
+		Params: map[string]any{
+			"groups": r.groups,
+		},
+		Rules: describeRules(r.rules),
+	}
+}
@@ -0,0 +1,89 @@
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GroupRule(t *testing.T) {
+	runRuleTestCases(t, groupRuleDataProvider)
+}
+
+func BenchmarkGroupRule(b *testing.B) {
+	runRuleBenchmarks(b, groupRuleDataProvider)
+}
+
+func Test_Group_Rules(t *testing.T) {
+	// given
+	for ttName, tt := range map[string]struct {
+		rule          *groupRule
+		activeGroups  []string
+		expectedRules []Rule
+	}{
+		"no active groups": {
+			rule:          Group([]string{"foo"}, newRuleMock(0), newRuleMock(1)),
+			activeGroups:  nil,
+			expectedRules: nil,
+		},
+		"no rule groups": {
+			rule:          Group(nil, newRuleMock(0), newRuleMock(1)),
+			activeGroups:  []string{"foo"},
+			expectedRules: nil,
+		},
+		"group is not active": {
+			rule:          Group([]string{"foo", "bar"}, newRuleMock(0), newRuleMock(1)),
+			activeGroups:  []string{"baz"},
+			expectedRules: nil,
+		},
+		"group is active": {
+			rule:         Group([]string{"foo", "bar"}, newRuleMock(0), newRuleMock(1)),
+			activeGroups: []string{"baz", "bar"},
+			expectedRules: []Rule{
+				newRuleMock(0),
+				newRuleMock(1),
+			},
+		},
+	} {
+		t.Run(ttName, func(t *testing.T) {
+			// given
+			ctx := ContextWithGroups(context.Background(), tt.activeGroups...)
+
+			// when
+			rules := tt.rule.Rules(ctx, nil, nil)
+
+			// then
+			require.Equal(t, tt.expectedRules, rules)
+		})
+	}
+}
+
+func Test_GroupsFromContext(t *testing.T) {
+	// given
+	var groupsDummy = []string{fakerInstance.Lorem().Word(), fakerInstance.Lorem().Word()}
+
+	// then
+	require.Nil(t, GroupsFromContext(context.Background()))
+	require.Equal(t, groupsDummy, GroupsFromContext(ContextWithGroups(context.Background(), groupsDummy...)))
+}
+
+func groupRuleDataProvider() map[string]*ruleTestCaseData {
+	var stringDummy = fakerInstance.Lorem().Sentence(6)
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Group([]string{"foo"}, nil),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+
+		"string": {
+			rule:             Group([]string{"foo"}, nil),
+			value:            stringDummy,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+	}
+}
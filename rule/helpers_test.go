@@ -19,12 +19,14 @@ type someStruct struct {
 type ruleTestCaseData struct {
 	rule                 Rule
 	value                any
+	missing              bool
 	data                 any
 	expectedNewValue     any
 	expectedNewValueFunc func(value any) bool
 	expectedError        ve.ValidationError
 	expectedErrorFunc    func(t *testing.T, err ve.ValidationError) bool
 	expectedToBail       bool
+	expectedToSkip       bool
 }
 
 type ruleTestCaseDataProvider func() map[string]*ruleTestCaseData
@@ -38,7 +40,7 @@ func runRuleTestCases(t *testing.T, dataProvider ruleTestCaseDataProvider) {
 	for ttName, tt := range dataProvider() {
 		t.Run(ttName, func(t *testing.T) {
 			// when
-			newValue, err := tt.rule.Apply(context.Background(), tt.value, tt.data)
+			newValue, err := applyRule(t, tt)
 
 			// then
 			switch {
@@ -67,10 +69,31 @@ func runRuleTestCases(t *testing.T, dataProvider ruleTestCaseDataProvider) {
 			} else {
 				require.False(t, tt.expectedToBail, "Rule is expected to be bailing")
 			}
+
+			if skippingRule, ok := tt.rule.(SkippingRule); ok {
+				if tt.expectedToSkip {
+					require.True(t, skippingRule.Skips(), "Rule is expected to skip")
+				} else {
+					require.False(t, skippingRule.Skips(), "Rule is expected to not skip")
+				}
+			} else {
+				require.False(t, tt.expectedToSkip, "Rule is expected to be skipping")
+			}
 		})
 	}
 }
 
+func applyRule(t *testing.T, tt *ruleTestCaseData) (any, ve.ValidationError) {
+	if !tt.missing {
+		return tt.rule.Apply(context.Background(), tt.value, tt.data)
+	}
+
+	presenceAwareRule, ok := tt.rule.(PresenceAwareRule)
+	require.True(t, ok, "Rule is expected to be presence aware")
+
+	return presenceAwareRule.ApplyWithPresence(context.Background(), tt.value, false, tt.data)
+}
+
 func runRuleBenchmarks(b *testing.B, dataProvider ruleTestCaseDataProvider) {
 	for ttName, tt := range dataProvider() {
 		b.Run(ttName, func(b *testing.B) {
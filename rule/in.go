@@ -77,6 +77,15 @@ func (r *inRule[T]) Apply(_ context.Context, value any, _ any) (any, ve.Validati
 	return value, NewInValidationError(r.values)
 }
 
+func (r *inRule[T]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleIn,
+		Params: map[string]any{
+			"values": r.values,
+		},
+	}
+}
+
 func NewInValidationError[T any](values []T) InValidationError[T] {
 	return InValidationError[T]{
 		BasicValidationError: ve.BasicValidationError{
@@ -33,6 +33,15 @@ func (r *integerRule[Out]) Apply(_ context.Context, value any, _ any) (any, ve.V
 	return value, nil
 }
 
+func (*integerRule[Out]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleInt,
+		Params: map[string]any{
+			"type": fmt.Sprintf("%T", *new(Out)),
+		},
+	}
+}
+
 func NewIntegerValidationError(expectedType, actualType string) IntegerValidationError {
 	return IntegerValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -27,6 +27,12 @@ func (*ipRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationErr
 	return value, nil
 }
 
+func (*ipRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleIP,
+	}
+}
+
 func NewIpValidationError() IpValidationError {
 	return IpValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -0,0 +1,90 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Keys(rules ...Rule) *keysRule {
+	return &keysRule{
+		rules: rules,
+	}
+}
+
+type keysRule struct {
+	rules []Rule
+}
+
+func (r *keysRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	v, isNil := Dereference(value)
+	if isNil {
+		return value, nil
+	}
+
+	valueOf := reflect.ValueOf(v)
+	if valueOf.Kind() != reflect.Map {
+		return value, nil
+	}
+
+	keysErrors := map[string][]ve.ValidationError{}
+
+	for _, key := range valueOf.MapKeys() {
+		if _, failures, _ := applyRules(ctx, key.Interface(), true, data, r.rules); len(failures) > 0 {
+			keysErrors[fmt.Sprint(key.Interface())] = failures
+		}
+	}
+
+	if len(keysErrors) > 0 {
+		return value, NewKeysValidationError(keysErrors)
+	}
+
+	return value, nil
+}
+
+func (r *keysRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:  ve.RuleKeys,
+		Rules: describeRules(r.rules),
+	}
+}
+
+func NewKeysValidationError(keysErrors map[string][]ve.ValidationError) KeysValidationError {
+	return KeysValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RuleKeys,
+		},
+		Errors: keysErrors,
+	}
+}
+
+type KeysValidationError struct {
+	ve.BasicValidationError
+
+	Errors map[string][]ve.ValidationError `json:"errors"`
+}
+
+func (e KeysValidationError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	messages := make([]string, len(keys))
+	for idx, key := range keys {
+		keyMessages := make([]string, len(e.Errors[key]))
+		for errorIdx, validationError := range e.Errors[key] {
+			keyMessages[errorIdx] = validationError.Error()
+		}
+
+		messages[idx] = fmt.Sprintf("%q: {%s}", key, strings.Join(keyMessages, "; "))
+	}
+
+	return fmt.Sprintf("has invalid keys: %s", strings.Join(messages, ", "))
+}
@@ -0,0 +1,95 @@
+package rule
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Test_KeysRule(t *testing.T) {
+	runRuleTestCases(t, keysRuleDataProvider)
+}
+
+func Test_KeysValidationError(t *testing.T) {
+	// when
+	err := NewKeysValidationError(map[string][]ve.ValidationError{
+		"foo": {NewRegexValidationError()},
+		"bar": {NewStringValidationError(), NewRegexValidationError()},
+	})
+
+	// then
+	require.EqualError(t, err, `has invalid keys: "bar": {must be a string; format is invalid}, "foo": {format is invalid}`)
+}
+
+func BenchmarkKeysRule(b *testing.B) {
+	runRuleBenchmarks(b, keysRuleDataProvider)
+}
+
+func keysRuleDataProvider() map[string]*ruleTestCaseData {
+	var (
+		regex = regexp.MustCompile(`^[a-z]+$`)
+
+		validMapDummy = map[string]int{
+			"foo": 1,
+			"bar": 2,
+		}
+		invalidMapDummy = map[string]int{
+			"foo":   1,
+			"Bar":   2,
+			"baz_1": 3,
+		}
+	)
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Keys(Regex(regex)),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+		"string": {
+			rule:             Keys(Regex(regex)),
+			value:            "Foo",
+			expectedNewValue: "Foo",
+			expectedError:    nil,
+		},
+
+		"map with valid keys": {
+			rule:             Keys(Regex(regex)),
+			value:            validMapDummy,
+			expectedNewValue: validMapDummy,
+			expectedError:    nil,
+		},
+		"pointer to map with valid keys": {
+			rule:             Keys(Regex(regex)),
+			value:            &validMapDummy,
+			expectedNewValue: &validMapDummy,
+			expectedError:    nil,
+		},
+		"map with invalid keys": {
+			rule:             Keys(Regex(regex)),
+			value:            invalidMapDummy,
+			expectedNewValue: invalidMapDummy,
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewKeysValidationError(map[string][]ve.ValidationError{
+					"Bar":   {NewRegexValidationError()},
+					"baz_1": {NewRegexValidationError()},
+				}), err)
+			},
+		},
+		"map with integer keys": {
+			rule:             Keys(Min(0)),
+			value:            map[int]string{-1: "foo", 1: "bar"},
+			expectedNewValue: map[int]string{-1: "foo", 1: "bar"},
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewKeysValidationError(map[string][]ve.ValidationError{
+					"-1": {NewMinValidationError(ve.TypeNumber, 0, true)},
+				}), err)
+			},
+		},
+	}
+}
@@ -55,6 +55,15 @@ func (r *lengthRule[T]) Apply(_ context.Context, value any, _ any) (any, ve.Vali
 	return value, nil
 }
 
+func (r *lengthRule[T]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleLength,
+		Params: map[string]any{
+			"length": r.length,
+		},
+	}
+}
+
 func NewLengthValidationError[T integerType](st string, threshold T) LengthValidationError[T] {
 	return LengthValidationError[T]{
 		BasicValidationError: ve.BasicValidationError{
@@ -30,6 +30,12 @@ func (r *mapRule) Apply(_ context.Context, value any, _ any) (any, ve.Validation
 	return value, nil
 }
 
+func (*mapRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleMap,
+	}
+}
+
 func NewMapValidationError() MapValidationError {
 	return MapValidationError{
 		BasicValidationError: ve.BasicValidationError{
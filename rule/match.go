@@ -0,0 +1,73 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type matchSelector[K comparable] func(ctx context.Context, value any, data any) K
+
+func Match[K comparable](selector matchSelector[K]) *matchRule[K] {
+	return &matchRule[K]{
+		selector: selector,
+		cases:    map[K][]Rule{},
+	}
+}
+
+type matchRule[K comparable] struct {
+	selector     matchSelector[K]
+	cases        map[K][]Rule
+	defaultRules []Rule
+}
+
+func (r *matchRule[K]) Case(key K, rules ...Rule) *matchRule[K] {
+	r.cases[key] = rules
+
+	return r
+}
+
+func (r *matchRule[K]) Default(rules ...Rule) *matchRule[K] {
+	r.defaultRules = rules
+
+	return r
+}
+
+func (r *matchRule[K]) Apply(_ context.Context, _ any, _ any) (any, ve.ValidationError) {
+	return nil, nil
+}
+
+func (r *matchRule[K]) Rules(ctx context.Context, value any, data any) []Rule {
+	if rules, exists := r.cases[r.selector(ctx, value, data)]; exists {
+		return rules
+	}
+
+	return r.defaultRules
+}
+
+func (r *matchRule[K]) Describe() RuleDescriptor {
+	cases := make([]K, 0, len(r.cases))
+	for key := range r.cases {
+		cases = append(cases, key)
+	}
+
+	sort.Slice(cases, func(i, j int) bool {
+		return fmt.Sprint(cases[i]) < fmt.Sprint(cases[j])
+	})
+
+	ruleSets := make([][]RuleDescriptor, len(cases))
+	for idx, key := range cases {
+		ruleSets[idx] = describeRules(r.cases[key])
+	}
+
+	return RuleDescriptor{
+		Name: ve.RuleMatch,
+		Params: map[string]any{
+			"cases": cases,
+		},
+		RuleSets: ruleSets,
+		Else:     describeRules(r.defaultRules),
+	}
+}
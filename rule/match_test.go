@@ -0,0 +1,94 @@
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MatchRule(t *testing.T) {
+	runRuleTestCases(t, matchRuleDataProvider)
+}
+
+func BenchmarkMatchRule(b *testing.B) {
+	runRuleBenchmarks(b, matchRuleDataProvider)
+}
+
+func Test_Match_Rules(t *testing.T) {
+	// given
+	selector := func(_ context.Context, _ any, data any) string {
+		return data.(map[string]any)["type"].(string)
+	}
+
+	for ttName, tt := range map[string]struct {
+		rule          *matchRule[string]
+		data          any
+		expectedRules []Rule
+	}{
+		"no cases, no default": {
+			rule:          Match(selector),
+			data:          map[string]any{"type": "card"},
+			expectedRules: nil,
+		},
+		"case does not match, no default": {
+			rule:          Match(selector).Case("bank", newRuleMock(0)),
+			data:          map[string]any{"type": "card"},
+			expectedRules: nil,
+		},
+		"case does not match, with default": {
+			rule: Match(selector).
+				Case("bank", newRuleMock(0)).
+				Default(newRuleMock(1), newRuleMock(2)),
+			data: map[string]any{"type": "card"},
+			expectedRules: []Rule{
+				newRuleMock(1),
+				newRuleMock(2),
+			},
+		},
+		"case matches": {
+			rule: Match(selector).
+				Case("bank", newRuleMock(0)).
+				Case("card", newRuleMock(1), newRuleMock(2)).
+				Default(newRuleMock(3)),
+			data: map[string]any{"type": "card"},
+			expectedRules: []Rule{
+				newRuleMock(1),
+				newRuleMock(2),
+			},
+		},
+	} {
+		t.Run(ttName, func(t *testing.T) {
+			// when
+			rules := tt.rule.Rules(context.Background(), nil, tt.data)
+
+			// then
+			require.Equal(t, tt.expectedRules, rules)
+		})
+	}
+}
+
+func matchRuleDataProvider() map[string]*ruleTestCaseData {
+	var stringDummy = fakerInstance.Lorem().Sentence(6)
+
+	selector := func(_ context.Context, value any, _ any) string {
+		s, _ := value.(string)
+
+		return s
+	}
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Match(selector).Case("", nil),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+		"string": {
+			rule:             Match(selector).Default(nil),
+			value:            stringDummy,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+	}
+}
@@ -117,6 +117,16 @@ func (r *maxRule[T]) Apply(_ context.Context, value any, _ any) (any, ve.Validat
 	return value, nil
 }
 
+func (r *maxRule[T]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleMax,
+		Params: map[string]any{
+			"threshold": r.max,
+			"inclusive": r.inclusive,
+		},
+	}
+}
+
 func isMax[V, T numberType](v V, min T, inclusive bool) bool {
 	if inclusive {
 		// v <= max
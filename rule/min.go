@@ -117,6 +117,16 @@ func (r *minRule[T]) Apply(_ context.Context, value any, _ any) (any, ve.Validat
 	return value, nil
 }
 
+func (r *minRule[T]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleMin,
+		Params: map[string]any{
+			"threshold": r.min,
+			"inclusive": r.inclusive,
+		},
+	}
+}
+
 func isMin[V, T numberType](v V, min T, inclusive bool) bool {
 	if inclusive {
 		// v >= min
@@ -0,0 +1,48 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Missing() *missingRule {
+	return &missingRule{}
+}
+
+type missingRule struct {
+}
+
+func (r *missingRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (*missingRule) ApplyWithPresence(_ context.Context, value any, present bool, _ any) (any, ve.ValidationError) {
+	if present {
+		return value, NewMissingValidationError()
+	}
+
+	return value, nil
+}
+
+func (*missingRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleMissing,
+	}
+}
+
+func NewMissingValidationError() MissingValidationError {
+	return MissingValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RuleMissing,
+		},
+	}
+}
+
+type MissingValidationError struct {
+	ve.BasicValidationError
+}
+
+func (MissingValidationError) Error() string {
+	return "must not be present"
+}
@@ -0,0 +1,61 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MissingRule(t *testing.T) {
+	runRuleTestCases(t, missingRuleDataProvider)
+}
+
+func Test_MissingValidationError(t *testing.T) {
+	// when
+	err := NewMissingValidationError()
+
+	// then
+	require.EqualError(t, err, "must not be present")
+}
+
+func BenchmarkMissingRule(b *testing.B) {
+	runRuleBenchmarks(b, missingRuleDataProvider)
+}
+
+func missingRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"missing": {
+			rule:             Missing(),
+			value:            nil,
+			missing:          true,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+
+		"nil": {
+			rule:             Missing(),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    NewMissingValidationError(),
+		},
+		"pointer to string nil pointer": {
+			rule:             Missing(),
+			value:            (*string)(nil),
+			expectedNewValue: (*string)(nil),
+			expectedError:    NewMissingValidationError(),
+		},
+
+		"int": {
+			rule:             Missing(),
+			value:            0,
+			expectedNewValue: 0,
+			expectedError:    NewMissingValidationError(),
+		},
+		"string": {
+			rule:             Missing(),
+			value:            "",
+			expectedNewValue: "",
+			expectedError:    NewMissingValidationError(),
+		},
+	}
+}
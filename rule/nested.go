@@ -0,0 +1,41 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Nested(rules map[string][]Rule) *nestedRule {
+	return &nestedRule{
+		rules: rules,
+	}
+}
+
+type nestedRule struct {
+	rules map[string][]Rule
+}
+
+func (*nestedRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	return value, nil
+}
+
+func (r *nestedRule) NestedRules(_ context.Context, value any, _ any) map[string][]Rule {
+	if _, isNil := Dereference(value); isNil {
+		return nil
+	}
+
+	return r.rules
+}
+
+func (r *nestedRule) Describe() RuleDescriptor {
+	fields := make(map[string][]RuleDescriptor, len(r.rules))
+	for field, rules := range r.rules {
+		fields[field] = describeRules(rules)
+	}
+
+	return RuleDescriptor{
+		Name:   ve.RuleNested,
+		Fields: fields,
+	}
+}
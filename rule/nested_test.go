@@ -0,0 +1,79 @@
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NestedRule(t *testing.T) {
+	runRuleTestCases(t, nestedRuleDataProvider)
+}
+
+func BenchmarkNestedRule(b *testing.B) {
+	runRuleBenchmarks(b, nestedRuleDataProvider)
+}
+
+func Test_Nested_NestedRules(t *testing.T) {
+	// given
+	var rules = map[string][]Rule{
+		"foo": {newRuleMock(0)},
+		"bar": {newRuleMock(1), newRuleMock(2)},
+	}
+
+	for ttName, tt := range map[string]struct {
+		value         any
+		expectedRules map[string][]Rule
+	}{
+		"nil": {
+			value:         nil,
+			expectedRules: nil,
+		},
+		"nil map": {
+			value:         (map[string]any)(nil),
+			expectedRules: nil,
+		},
+		"pointer to struct nil pointer": {
+			value:         (*someStruct)(nil),
+			expectedRules: nil,
+		},
+		"map": {
+			value:         map[string]any{},
+			expectedRules: rules,
+		},
+		"struct": {
+			value:         someStruct{},
+			expectedRules: rules,
+		},
+	} {
+		t.Run(ttName, func(t *testing.T) {
+			// when
+			nestedRules := Nested(rules).NestedRules(context.Background(), tt.value, nil)
+
+			// then
+			require.Equal(t, tt.expectedRules, nestedRules)
+		})
+	}
+}
+
+func nestedRuleDataProvider() map[string]*ruleTestCaseData {
+	var mapDummy = map[string]any{
+		"foo": fakerInstance.Lorem().Word(),
+	}
+
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Nested(nil),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+		"map": {
+			rule:             Nested(nil),
+			value:            mapDummy,
+			expectedNewValue: mapDummy,
+			expectedError:    nil,
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Not(rules ...Rule) *notRule {
+	return &notRule{
+		rules: rules,
+	}
+}
+
+type notRule struct {
+	rules []Rule
+}
+
+func (r *notRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *notRule) ApplyWithPresence(ctx context.Context, value any, present bool, data any) (any, ve.ValidationError) {
+	if _, failures, _ := applyRules(ctx, value, present, data, r.rules); len(failures) == 0 {
+		return value, NewNotValidationError()
+	}
+
+	return value, nil
+}
+
+func (r *notRule) Combines() bool {
+	return true
+}
+
+func (r *notRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:  ve.RuleNot,
+		Rules: describeRules(r.rules),
+	}
+}
+
+func NewNotValidationError() NotValidationError {
+	return NotValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RuleNot,
+		},
+	}
+}
+
+type NotValidationError struct {
+	ve.BasicValidationError
+}
+
+func (NotValidationError) Error() string {
+	return "must not pass the rules"
+}
@@ -77,6 +77,15 @@ func (r *notInRule[T]) Apply(_ context.Context, value any, _ any) (any, ve.Valid
 	return value, nil
 }
 
+func (r *notInRule[T]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleNotIn,
+		Params: map[string]any{
+			"values": r.values,
+		},
+	}
+}
+
 func NewNotInValidationError[T any](values []T) NotInValidationError[T] {
 	return NotInValidationError[T]{
 		BasicValidationError: ve.BasicValidationError{
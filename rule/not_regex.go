@@ -30,6 +30,15 @@ func (r *notRegexRule) Apply(_ context.Context, value any, _ any) (any, ve.Valid
 	return value, nil
 }
 
+func (r *notRegexRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleNotRegex,
+		Params: map[string]any{
+			"pattern": r.regex.String(),
+		},
+	}
+}
+
 func NewNotRegexValidationError() NotRegexValidationError {
 	return NotRegexValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -0,0 +1,77 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NotRule(t *testing.T) {
+	runRuleTestCases(t, notRuleDataProvider)
+}
+
+func Test_NotValidationError(t *testing.T) {
+	// when
+	err := NewNotValidationError()
+
+	// then
+	require.EqualError(t, err, "must not pass the rules")
+}
+
+func BenchmarkNotRule(b *testing.B) {
+	runRuleBenchmarks(b, notRuleDataProvider)
+}
+
+func notRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"rules fail": {
+			rule:             Not(Numeric()),
+			value:            "foo",
+			expectedNewValue: "foo",
+			expectedError:    nil,
+		},
+		"rules pass, value is not modified": {
+			rule:             Not(Numeric()),
+			value:            "123",
+			expectedNewValue: "123",
+			expectedError:    NewNotValidationError(),
+		},
+		"some of rules fail": {
+			rule:             Not(Numeric(), Min(200)),
+			value:            "123",
+			expectedNewValue: "123",
+			expectedError:    nil,
+		},
+		"rules return warnings only": {
+			rule:             Not(Warn(Numeric())),
+			value:            "foo",
+			expectedNewValue: "foo",
+			expectedError:    NewNotValidationError(),
+		},
+		"nested rules fail": {
+			rule:             Not(Nested(map[string][]Rule{"foo": {Integer[int]()}})),
+			value:            map[string]any{"foo": "bar"},
+			expectedNewValue: map[string]any{"foo": "bar"},
+			expectedError:    nil,
+		},
+		"nested rules pass": {
+			rule:             Not(Nested(map[string][]Rule{"foo": {Integer[int]()}})),
+			value:            map[string]any{"foo": 1},
+			expectedNewValue: map[string]any{"foo": 1},
+			expectedError:    NewNotValidationError(),
+		},
+		"element rules fail": {
+			rule:             Not(Each(Integer[int]())),
+			value:            []any{1, "x"},
+			expectedNewValue: []any{1, "x"},
+			expectedError:    nil,
+		},
+		"missing value": {
+			rule:             Not(Present()),
+			value:            nil,
+			missing:          true,
+			expectedNewValue: nil,
+			expectedError:    nil,
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Nullable() *nullableRule {
+	return &nullableRule{}
+}
+
+type nullableRule struct {
+	Skipper
+}
+
+func (r *nullableRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	if _, isNil := Dereference(value); isNil {
+		r.MarkSkipped()
+	}
+
+	return value, nil
+}
+
+func (*nullableRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleNullable,
+	}
+}
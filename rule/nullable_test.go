@@ -0,0 +1,61 @@
+package rule
+
+import (
+	"testing"
+)
+
+func Test_NullableRule(t *testing.T) {
+	runRuleTestCases(t, nullableRuleDataProvider)
+}
+
+func BenchmarkNullableRule(b *testing.B) {
+	runRuleBenchmarks(b, nullableRuleDataProvider)
+}
+
+func nullableRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"nil": {
+			rule:             Nullable(),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+			expectedToSkip:   true,
+		},
+		"pointer to string nil pointer": {
+			rule:             Nullable(),
+			value:            (*string)(nil),
+			expectedNewValue: (*string)(nil),
+			expectedError:    nil,
+			expectedToSkip:   true,
+		},
+		"nil slice": {
+			rule:             Nullable(),
+			value:            ([]int)(nil),
+			expectedNewValue: ([]int)(nil),
+			expectedError:    nil,
+			expectedToSkip:   true,
+		},
+
+		"int": {
+			rule:             Nullable(),
+			value:            0,
+			expectedNewValue: 0,
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+		"string": {
+			rule:             Nullable(),
+			value:            "",
+			expectedNewValue: "",
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+		"pointer to string": {
+			rule:             Nullable(),
+			value:            ptr(""),
+			expectedNewValue: ptr(""),
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+	}
+}
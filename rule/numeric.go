@@ -48,6 +48,12 @@ func (*numericRule) Apply(_ context.Context, value any, _ any) (any, ve.Validati
 	return value, NewNumericValidationError()
 }
 
+func (*numericRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleNumeric,
+	}
+}
+
 func NewNumericValidationError() NumericValidationError {
 	return NumericValidationError{
 		BasicValidationError: ve.BasicValidationError{
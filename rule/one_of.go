@@ -0,0 +1,91 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func OneOf(ruleSets ...[]Rule) *oneOfRule {
+	return &oneOfRule{
+		ruleSets: ruleSets,
+	}
+}
+
+type oneOfRule struct {
+	ruleSets [][]Rule
+}
+
+func (r *oneOfRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *oneOfRule) ApplyWithPresence(ctx context.Context, value any, present bool, data any) (any, ve.ValidationError) {
+	var (
+		ruleSetsErrors = make([][]ve.ValidationError, len(r.ruleSets))
+		passed         []int
+		passedValue    any
+		passedNotices  ve.ValidationError
+	)
+
+	for idx, rules := range r.ruleSets {
+		newValue, failures, notices := applyRules(ctx, value, present, data, rules)
+		if len(failures) > 0 {
+			ruleSetsErrors[idx] = failures
+
+			continue
+		}
+
+		passed = append(passed, idx)
+		passedValue = newValue
+		passedNotices = notices
+	}
+
+	switch len(passed) {
+	case 1:
+		return passedValue, passedNotices
+
+	case 0:
+		return value, NewOneOfValidationError(ruleSetsErrors, nil)
+
+	default:
+		return value, NewOneOfValidationError(nil, passed)
+	}
+}
+
+func (r *oneOfRule) Combines() bool {
+	return true
+}
+
+func (r *oneOfRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:     ve.RuleOneOf,
+		RuleSets: describeRuleSets(r.ruleSets),
+	}
+}
+
+func NewOneOfValidationError(ruleSetsErrors [][]ve.ValidationError, passed []int) OneOfValidationError {
+	return OneOfValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RuleOneOf,
+		},
+		Errors: ruleSetsErrors,
+		Passed: passed,
+	}
+}
+
+type OneOfValidationError struct {
+	ve.BasicValidationError
+
+	Errors [][]ve.ValidationError `json:"errors,omitempty"`
+	Passed []int                  `json:"passed,omitempty"`
+}
+
+func (e OneOfValidationError) Error() string {
+	if len(e.Passed) > 1 {
+		return fmt.Sprintf("must pass exactly one of rule sets, but passes %v", e.Passed)
+	}
+
+	return fmt.Sprintf("must pass exactly one of rule sets: %s", formatRuleSetsErrors(e.Errors))
+}
@@ -0,0 +1,71 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Test_OneOfRule(t *testing.T) {
+	runRuleTestCases(t, oneOfRuleDataProvider)
+}
+
+func Test_OneOfValidationError(t *testing.T) {
+	t.Run("no rule set passes", func(t *testing.T) {
+		// when
+		err := NewOneOfValidationError([][]ve.ValidationError{
+			{NewUuidValidationError()},
+			{NewNumericValidationError()},
+		}, nil)
+
+		// then
+		require.EqualError(t, err, "must pass exactly one of rule sets: #0: {must be a valid UUID}, #1: {must be a number}")
+	})
+
+	t.Run("many rule sets pass", func(t *testing.T) {
+		// when
+		err := NewOneOfValidationError(nil, []int{0, 2})
+
+		// then
+		require.EqualError(t, err, "must pass exactly one of rule sets, but passes [0 2]")
+	})
+}
+
+func BenchmarkOneOfRule(b *testing.B) {
+	runRuleBenchmarks(b, oneOfRuleDataProvider)
+}
+
+func oneOfRuleDataProvider() map[string]*ruleTestCaseData {
+	var uuidDummy = fakerInstance.UUID().V4()
+
+	return map[string]*ruleTestCaseData{
+		"exactly one rule set passes": {
+			rule:             OneOf([]Rule{UUID()}, []Rule{Numeric()}),
+			value:            "123",
+			expectedNewValue: int64(123),
+			expectedError:    nil,
+		},
+		"no rule set passes": {
+			rule:             OneOf([]Rule{UUID()}, []Rule{Numeric()}),
+			value:            "foo",
+			expectedNewValue: "foo",
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewOneOfValidationError([][]ve.ValidationError{
+					{NewUuidValidationError()},
+					{NewNumericValidationError()},
+				}, nil), err)
+			},
+		},
+		"many rule sets pass": {
+			rule:             OneOf([]Rule{UUID()}, []Rule{Numeric()}, []Rule{String()}),
+			value:            uuidDummy,
+			expectedNewValue: uuidDummy,
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, NewOneOfValidationError(nil, []int{0, 2}), err)
+			},
+		},
+	}
+}
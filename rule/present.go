@@ -0,0 +1,51 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Present() *presentRule {
+	return &presentRule{}
+}
+
+type presentRule struct {
+	Bailer
+}
+
+func (r *presentRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *presentRule) ApplyWithPresence(_ context.Context, value any, present bool, _ any) (any, ve.ValidationError) {
+	if !present {
+		r.MarkBailed()
+
+		return value, NewPresentValidationError()
+	}
+
+	return value, nil
+}
+
+func (*presentRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RulePresent,
+	}
+}
+
+func NewPresentValidationError() PresentValidationError {
+	return PresentValidationError{
+		BasicValidationError: ve.BasicValidationError{
+			Rule: ve.RulePresent,
+		},
+	}
+}
+
+type PresentValidationError struct {
+	ve.BasicValidationError
+}
+
+func (PresentValidationError) Error() string {
+	return "must be present"
+}
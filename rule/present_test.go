@@ -0,0 +1,80 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PresentRule(t *testing.T) {
+	runRuleTestCases(t, presentRuleDataProvider)
+}
+
+func Test_PresentValidationError(t *testing.T) {
+	// when
+	err := NewPresentValidationError()
+
+	// then
+	require.EqualError(t, err, "must be present")
+}
+
+func BenchmarkPresentRule(b *testing.B) {
+	runRuleBenchmarks(b, presentRuleDataProvider)
+}
+
+func presentRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"missing": {
+			rule:             Present(),
+			value:            nil,
+			missing:          true,
+			expectedNewValue: nil,
+			expectedError:    NewPresentValidationError(),
+			expectedToBail:   true,
+		},
+
+		"nil": {
+			rule:             Present(),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+			expectedToBail:   false,
+		},
+		"pointer to string nil pointer": {
+			rule:             Present(),
+			value:            (*string)(nil),
+			expectedNewValue: (*string)(nil),
+			expectedError:    nil,
+			expectedToBail:   false,
+		},
+
+		"int": {
+			rule:             Present(),
+			value:            0,
+			expectedNewValue: 0,
+			expectedError:    nil,
+			expectedToBail:   false,
+		},
+		"string": {
+			rule:             Present(),
+			value:            "",
+			expectedNewValue: "",
+			expectedError:    nil,
+			expectedToBail:   false,
+		},
+		"slice": {
+			rule:             Present(),
+			value:            []int{},
+			expectedNewValue: []int{},
+			expectedError:    nil,
+			expectedToBail:   false,
+		},
+		"struct": {
+			rule:             Present(),
+			value:            someStruct{},
+			expectedNewValue: someStruct{},
+			expectedError:    nil,
+			expectedToBail:   false,
+		},
+	}
+}
@@ -30,6 +30,15 @@ func (r *regexRule) Apply(_ context.Context, value any, _ any) (any, ve.Validati
 	return value, nil
 }
 
+func (r *regexRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleRegex,
+		Params: map[string]any{
+			"pattern": r.regex.String(),
+		},
+	}
+}
+
 func NewRegexValidationError() RegexValidationError {
 	return RegexValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -30,6 +30,12 @@ func (r *requiredRule) Apply(_ context.Context, value any, _ any) (any, ve.Valid
 	return value, nil
 }
 
+func (*requiredRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleRequired,
+	}
+}
+
 func NewRequiredValidationError() RequiredValidationError {
 	return RequiredValidationError{
 		BasicValidationError: ve.BasicValidationError{
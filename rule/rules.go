@@ -2,10 +2,14 @@ package rule
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
+	"strings"
 
 	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/internal/engine"
 )
 
 type integerType interface {
@@ -30,10 +34,65 @@ type WithSubRulesRule interface {
 	Rules(ctx context.Context, value any, data any) []Rule
 }
 
+type NestedRule interface {
+	NestedRules(ctx context.Context, value any, data any) map[string][]Rule
+}
+
 type BailingRule interface {
 	Bails() bool
 }
 
+type PresenceAwareRule interface {
+	ApplyWithPresence(ctx context.Context, value any, present bool, data any) (newValue any, err ve.ValidationError)
+}
+
+type SkippingRule interface {
+	Skips() bool
+}
+
+type DescribableRule interface {
+	Describe() RuleDescriptor
+}
+
+type RuleDescriptor struct {
+	Name     string                      `json:"name"`
+	Params   map[string]any              `json:"params,omitempty"`
+	Rules    []RuleDescriptor            `json:"rules,omitempty"`
+	Else     []RuleDescriptor            `json:"else,omitempty"`
+	RuleSets [][]RuleDescriptor          `json:"rule_sets,omitempty"`
+	Fields   map[string][]RuleDescriptor `json:"fields,omitempty"`
+}
+
+func Describe(rule Rule) RuleDescriptor {
+	if describableRule, ok := rule.(DescribableRule); ok {
+		return describableRule.Describe()
+	}
+
+	return RuleDescriptor{Name: fmt.Sprintf("%T", rule)}
+}
+
+func describeRules(rules []Rule) []RuleDescriptor {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	descriptors := make([]RuleDescriptor, len(rules))
+	for idx, rule := range rules {
+		descriptors[idx] = Describe(rule)
+	}
+
+	return descriptors
+}
+
+func describeRuleSets(ruleSets [][]Rule) [][]RuleDescriptor {
+	descriptors := make([][]RuleDescriptor, len(ruleSets))
+	for idx, rules := range ruleSets {
+		descriptors[idx] = describeRules(rules)
+	}
+
+	return descriptors
+}
+
 type Bailer struct {
 	bailed bool
 }
@@ -48,35 +107,60 @@ func (b *Bailer) Bails() bool {
 	return b.bailed
 }
 
-func Dereference(reference any) (value any, isNil bool) {
-	switch valueOf := reflect.ValueOf(reference); valueOf.Kind() {
-	case reflect.Invalid:
-		return nil, true
-
-	case reflect.Ptr,
-		reflect.Interface:
-		if valueOf.IsNil() {
-			return nil, true
-		}
+type Skipper struct {
+	skipped bool
+}
 
-		return Dereference(valueOf.Elem().Interface())
+func (s *Skipper) MarkSkipped() {
+	s.skipped = true
+}
 
-	case reflect.Slice,
-		reflect.Map,
-		reflect.Func,
-		reflect.Chan:
-		if valueOf.IsNil() {
-			return valueOf.Interface(), true
-		}
-	}
+func (s *Skipper) Skips() bool {
+	defer func() { s.skipped = false }()
 
-	return reference, false
+	return s.skipped
+}
+
+func Dereference(reference any) (value any, isNil bool) {
+	return engine.Dereference(reference)
 }
 
 func CompareNumbers[N1, N2 numberType](n1 N1, n2 N2) int {
 	return toBigFloat(n1).Cmp(toBigFloat(n2))
 }
 
+func ToFloat(value any) (float64, bool) {
+	valueOf := reflect.ValueOf(value)
+
+	switch {
+	case valueOf.CanInt():
+		return float64(valueOf.Int()), true
+
+	case valueOf.CanUint():
+		return float64(valueOf.Uint()), true
+
+	case valueOf.CanFloat():
+		return valueOf.Float(), true
+
+	default:
+		return 0, false
+	}
+}
+
+func ToSlice(value any) []any {
+	valueOf := reflect.ValueOf(value)
+	if valueOf.Kind() != reflect.Slice {
+		return nil
+	}
+
+	values := make([]any, valueOf.Len())
+	for idx := range values {
+		values[idx] = valueOf.Index(idx).Interface()
+	}
+
+	return values
+}
+
 func toBigFloat[T numberType](n T) *big.Float {
 	v := &big.Float{}
 
@@ -93,3 +177,80 @@ func toBigFloat[T numberType](n T) *big.Float {
 
 	return v
 }
+
+func applyRules(ctx context.Context, value any, present bool, data any, rules []Rule) (newValue any, failures []ve.ValidationError, notices ve.ValidationError) {
+	nested, ok := engine.NestedFuncFromContext[Rule](ctx)
+	if !ok {
+		nested = applyNestedRules
+	}
+
+	result, _ := engine.Runner[Rule]{Nested: nested, Combinator: nested}.Run(ctx, value, present, data, rules)
+
+	errors := result.Errors
+
+	fields := make([]string, 0, len(result.Nested))
+	for field := range result.Nested {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		for _, err := range result.Nested[field] {
+			errors = append(errors, ve.NewFieldValidationError(field, err))
+		}
+	}
+
+	var noticesList []ve.ValidationError
+
+	for _, err := range errors {
+		if ve.SeverityOf(err) == ve.SeverityError {
+			failures = append(failures, err)
+		} else {
+			noticesList = append(noticesList, err)
+		}
+	}
+
+	return result.Value, failures, ve.Join(noticesList...)
+}
+
+func applyNestedRules(ctx context.Context, data any, targets []engine.Target[Rule]) (ve.ErrorsBag, error) {
+	errorsBag := ve.NewErrorsBag()
+	activeGroups := GroupsFromContext(ctx)
+
+targets:
+	for _, target := range targets {
+		for _, groups := range target.Groups {
+			if !IsAnyGroupActive(activeGroups, groups) {
+				continue targets
+			}
+		}
+
+		_, failures, notices := applyRules(ctx, target.Value, !target.Missing, data, target.Rules)
+
+		if errors := append(failures, ve.Flatten(notices)...); len(errors) > 0 {
+			errorsBag.Add(target.Field, errors...)
+		}
+	}
+
+	return errorsBag, nil
+}
+
+func formatRuleSetsErrors(ruleSetsErrors [][]ve.ValidationError) string {
+	messages := make([]string, 0, len(ruleSetsErrors))
+
+	for idx, errors := range ruleSetsErrors {
+		if len(errors) == 0 {
+			continue
+		}
+
+		ruleSetMessages := make([]string, len(errors))
+		for errorIdx, validationError := range errors {
+			ruleSetMessages[errorIdx] = validationError.Error()
+		}
+
+		messages = append(messages, fmt.Sprintf("#%d: {%s}", idx, strings.Join(ruleSetMessages, "; ")))
+	}
+
+	return strings.Join(messages, ", ")
+}
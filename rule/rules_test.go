@@ -1,10 +1,15 @@
 package rule
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
 )
 
 func Test_Bailer(t *testing.T) {
@@ -147,6 +152,98 @@ func Test_Dereference(t *testing.T) {
 	}
 }
 
+type undescribableRule struct{}
+
+func (undescribableRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	return value, nil
+}
+
+func Test_DescribableRule(t *testing.T) {
+	moment := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for ttIdx, tt := range []struct {
+		rule     DescribableRule
+		expected RuleDescriptor
+	}{
+		{rule: Array(), expected: RuleDescriptor{Name: ve.RuleArray}},
+		{rule: Between(1, 2.5), expected: RuleDescriptor{Name: ve.RuleBetween, Params: map[string]any{"min": 1.0, "max": 2.5, "inclusive": true}}},
+		{rule: BetweenExclusive(1, 2), expected: RuleDescriptor{Name: ve.RuleBetween, Params: map[string]any{"min": 1, "max": 2, "inclusive": false}}},
+		{rule: Boolean(), expected: RuleDescriptor{Name: ve.RuleBoolean}},
+		{rule: Date(), expected: RuleDescriptor{Name: ve.RuleDateFormat, Params: map[string]any{"format": time.RFC3339Nano}}},
+		{rule: DateFormat("2006-01-02"), expected: RuleDescriptor{Name: ve.RuleDateFormat, Params: map[string]any{"format": "2006-01-02"}}},
+		{rule: Email(), expected: RuleDescriptor{Name: ve.RuleEmail}},
+		{rule: EmailAddress(), expected: RuleDescriptor{Name: ve.RuleEmail}},
+		{rule: Filled(), expected: RuleDescriptor{Name: ve.RuleFilled}},
+		{rule: Float[float32](), expected: RuleDescriptor{Name: ve.RuleFloat, Params: map[string]any{"type": "float32"}}},
+		{rule: In([]string{"a", "b"}), expected: RuleDescriptor{Name: ve.RuleIn, Params: map[string]any{"values": []string{"a", "b"}}}},
+		{rule: Integer[uint8](), expected: RuleDescriptor{Name: ve.RuleInt, Params: map[string]any{"type": "uint8"}}},
+		{rule: IP(), expected: RuleDescriptor{Name: ve.RuleIP}},
+		{rule: Length(3), expected: RuleDescriptor{Name: ve.RuleLength, Params: map[string]any{"length": 3}}},
+		{rule: Map(), expected: RuleDescriptor{Name: ve.RuleMap}},
+		{rule: Max(3), expected: RuleDescriptor{Name: ve.RuleMax, Params: map[string]any{"threshold": 3, "inclusive": true}}},
+		{rule: MaxExclusive(3), expected: RuleDescriptor{Name: ve.RuleMax, Params: map[string]any{"threshold": 3, "inclusive": false}}},
+		{rule: Min(1.5), expected: RuleDescriptor{Name: ve.RuleMin, Params: map[string]any{"threshold": 1.5, "inclusive": true}}},
+		{rule: MinExclusive(1), expected: RuleDescriptor{Name: ve.RuleMin, Params: map[string]any{"threshold": 1, "inclusive": false}}},
+		{rule: NotIn([]int{1, 2}), expected: RuleDescriptor{Name: ve.RuleNotIn, Params: map[string]any{"values": []int{1, 2}}}},
+		{rule: NotRegex(regexp.MustCompile("^a$")), expected: RuleDescriptor{Name: ve.RuleNotRegex, Params: map[string]any{"pattern": "^a$"}}},
+		{rule: Nullable(), expected: RuleDescriptor{Name: ve.RuleNullable}},
+		{rule: Numeric(), expected: RuleDescriptor{Name: ve.RuleNumeric}},
+		{rule: Regex(regexp.MustCompile("^a$")), expected: RuleDescriptor{Name: ve.RuleRegex, Params: map[string]any{"pattern": "^a$"}}},
+		{rule: Required(), expected: RuleDescriptor{Name: ve.RuleRequired}},
+		{rule: Slice(), expected: RuleDescriptor{Name: ve.RuleSlice}},
+		{rule: String(), expected: RuleDescriptor{Name: ve.RuleString}},
+		{rule: Struct(), expected: RuleDescriptor{Name: ve.RuleStruct}},
+		{rule: URL(), expected: RuleDescriptor{Name: ve.RuleURL}},
+		{rule: UUID(), expected: RuleDescriptor{Name: ve.RuleUUID, Params: map[string]any{"versions": []int(nil), "allow_nil": true}}},
+		{rule: UUID(UUIDRuleVersion5(), UUIDRuleVersion1(), UUIDRuleDisallowNilUUID()), expected: RuleDescriptor{Name: ve.RuleUUID, Params: map[string]any{"versions": []int{1, 5}, "allow_nil": false}}},
+		{rule: After(moment), expected: RuleDescriptor{Name: ve.RuleAfter, Params: map[string]any{"time": moment}}},
+		{rule: AfterOrEqual(moment), expected: RuleDescriptor{Name: ve.RuleAfterOrEqual, Params: map[string]any{"time": moment}}},
+		{rule: Before(moment), expected: RuleDescriptor{Name: ve.RuleBefore, Params: map[string]any{"time": moment}}},
+		{rule: BeforeOrEqual(moment), expected: RuleDescriptor{Name: ve.RuleBeforeOrEqual, Params: map[string]any{"time": moment}}},
+		{rule: ArrayOf[int](), expected: RuleDescriptor{Name: ve.RuleArrayOf, Params: map[string]any{"type": "int"}}},
+		{rule: SliceOf[string](), expected: RuleDescriptor{Name: ve.RuleSliceOf, Params: map[string]any{"type": "string"}}},
+		{rule: Bail(), expected: RuleDescriptor{Name: ve.RuleBail}},
+		{rule: Custom(func(_ context.Context, value int, _ any) (string, error) { return "", nil }), expected: RuleDescriptor{Name: ve.RuleCustom, Params: map[string]any{"in": "int", "out": "string"}}},
+		{rule: Default(5), expected: RuleDescriptor{Name: ve.RuleDefault, Params: map[string]any{"value": 5}}},
+		{rule: DefaultFunc(func(_ context.Context, _ any) any { return 5 }), expected: RuleDescriptor{Name: ve.RuleDefault}},
+		{rule: StartsWith("a", "b"), expected: RuleDescriptor{Name: ve.RuleStartsWith, Params: map[string]any{"prefixes": []string{"a", "b"}}}},
+		{rule: DoesntStartWith("a"), expected: RuleDescriptor{Name: ve.RuleDoesntStartWith, Params: map[string]any{"prefixes": []string{"a"}}}},
+		{rule: EndsWith("a", "b"), expected: RuleDescriptor{Name: ve.RuleEndsWith, Params: map[string]any{"suffixes": []string{"a", "b"}}}},
+		{rule: DoesntEndWith("a"), expected: RuleDescriptor{Name: ve.RuleDoesntEndWith, Params: map[string]any{"suffixes": []string{"a"}}}},
+		{rule: Duration(), expected: RuleDescriptor{Name: ve.RuleDuration}},
+		{rule: Missing(), expected: RuleDescriptor{Name: ve.RuleMissing}},
+		{rule: Present(), expected: RuleDescriptor{Name: ve.RulePresent}},
+		{rule: Sometimes(), expected: RuleDescriptor{Name: ve.RuleSometimes}},
+		{rule: Each(Required(), undescribableRule{}), expected: RuleDescriptor{Name: ve.RuleEach, Rules: []RuleDescriptor{{Name: ve.RuleRequired}, {Name: "rule.undescribableRule"}}}},
+		{rule: Keys(String()), expected: RuleDescriptor{Name: ve.RuleKeys, Rules: []RuleDescriptor{{Name: ve.RuleString}}}},
+		{rule: Not(Min(3)), expected: RuleDescriptor{Name: ve.RuleNot, Rules: []RuleDescriptor{{Name: ve.RuleMin, Params: map[string]any{"threshold": 3, "inclusive": true}}}}},
+		{rule: Group([]string{"admin"}, Required()), expected: RuleDescriptor{Name: ve.RuleGroup, Params: map[string]any{"groups": []string{"admin"}}, Rules: []RuleDescriptor{{Name: ve.RuleRequired}}}},
+		{rule: AllOf([]Rule{String()}, []Rule{Filled()}), expected: RuleDescriptor{Name: ve.RuleAllOf, RuleSets: [][]RuleDescriptor{{{Name: ve.RuleString}}, {{Name: ve.RuleFilled}}}}},
+		{rule: AnyOf([]Rule{String()}, nil), expected: RuleDescriptor{Name: ve.RuleAnyOf, RuleSets: [][]RuleDescriptor{{{Name: ve.RuleString}}, nil}}},
+		{rule: OneOf([]Rule{Map()}), expected: RuleDescriptor{Name: ve.RuleOneOf, RuleSets: [][]RuleDescriptor{{{Name: ve.RuleMap}}}}},
+		{rule: Nested(map[string][]Rule{"name": {Required()}}), expected: RuleDescriptor{Name: ve.RuleNested, Fields: map[string][]RuleDescriptor{"name": {{Name: ve.RuleRequired}}}}},
+		{rule: When(true, Required()), expected: RuleDescriptor{Name: ve.RuleWhen, Rules: []RuleDescriptor{{Name: ve.RuleRequired}}}},
+		{rule: WhenFunc(func(_ context.Context, _ any, _ any) bool { return true }, Required()).Else(Nullable(), String()), expected: RuleDescriptor{Name: ve.RuleWhen, Rules: []RuleDescriptor{{Name: ve.RuleRequired}}, Else: []RuleDescriptor{{Name: ve.RuleNullable}, {Name: ve.RuleString}}}},
+		{rule: Match(func(_ context.Context, _ any, _ any) int { return 0 }).Case(10, Required()).Case(2, String()).Default(Filled()), expected: RuleDescriptor{Name: ve.RuleMatch, Params: map[string]any{"cases": []int{10, 2}}, RuleSets: [][]RuleDescriptor{{{Name: ve.RuleRequired}}, {{Name: ve.RuleString}}}, Else: []RuleDescriptor{{Name: ve.RuleFilled}}}},
+	} {
+		require.Equal(t, tt.expected, tt.rule.Describe(), ttIdx)
+	}
+}
+
+func Test_Describe(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		rule     Rule
+		expected RuleDescriptor
+	}{
+		{rule: Required(), expected: RuleDescriptor{Name: ve.RuleRequired}},
+		{rule: Min(3), expected: RuleDescriptor{Name: ve.RuleMin, Params: map[string]any{"threshold": 3, "inclusive": true}}},
+		{rule: undescribableRule{}, expected: RuleDescriptor{Name: "rule.undescribableRule"}},
+		{rule: &undescribableRule{}, expected: RuleDescriptor{Name: "*rule.undescribableRule"}},
+	} {
+		require.Equal(t, tt.expected, Describe(tt.rule), ttIdx)
+	}
+}
+
 func Test_CompareNumbers(t *testing.T) {
 	// given
 	for _, tt := range []compareNumbersTestCase[int, int]{
@@ -338,6 +435,53 @@ func Test_CompareNumbers(t *testing.T) {
 	}
 }
 
+func Test_ToFloat(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		value          any
+		expectedNumber float64
+		expectedOk     bool
+	}{
+		{value: 5, expectedNumber: 5, expectedOk: true},
+		{value: int8(-5), expectedNumber: -5, expectedOk: true},
+		{value: uint64(5), expectedNumber: 5, expectedOk: true},
+		{value: float32(1.5), expectedNumber: 1.5, expectedOk: true},
+		{value: 1.5, expectedNumber: 1.5, expectedOk: true},
+		{value: "5", expectedNumber: 0, expectedOk: false},
+		{value: nil, expectedNumber: 0, expectedOk: false},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			number, ok := ToFloat(tt.value)
+
+			// then
+			require.Equal(t, tt.expectedNumber, number)
+			require.Equal(t, tt.expectedOk, ok)
+		})
+	}
+}
+
+func Test_ToSlice(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		value    any
+		expected []any
+	}{
+		{value: []int{1, 2}, expected: []any{1, 2}},
+		{value: []string{}, expected: []any{}},
+		{value: []any{"a", 1}, expected: []any{"a", 1}},
+		{value: [2]int{1, 2}, expected: nil},
+		{value: "a", expected: nil},
+		{value: nil, expected: nil},
+	} {
+		t.Run(fmt.Sprintf("#%d", ttIdx), func(t *testing.T) {
+			// when
+			values := ToSlice(tt.value)
+
+			// then
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
 func BenchmarkCompareNumbers(b *testing.B) {
 	runCompareNumbersBenchmark[int, int](b, 5, 5)
 	runCompareNumbersBenchmark[int, uint](b, 5, 5)
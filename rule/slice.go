@@ -30,6 +30,12 @@ func (r *sliceRule) Apply(_ context.Context, value any, _ any) (any, ve.Validati
 	return value, nil
 }
 
+func (*sliceRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleSlice,
+	}
+}
+
 func NewSliceValidationError() SliceValidationError {
 	return SliceValidationError{
 		BasicValidationError: ve.BasicValidationError{
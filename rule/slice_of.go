@@ -35,6 +35,15 @@ func (r *sliceOfRule[Out]) Apply(_ context.Context, value any, _ any) (any, ve.V
 	return value, nil
 }
 
+func (*sliceOfRule[Out]) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleSliceOf,
+		Params: map[string]any{
+			"type": fmt.Sprintf("%T", *new(Out)),
+		},
+	}
+}
+
 func NewSliceOfValidationError(expected, actual string) SliceOfValidationError {
 	return SliceOfValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -0,0 +1,33 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Sometimes() *sometimesRule {
+	return &sometimesRule{}
+}
+
+type sometimesRule struct {
+	Skipper
+}
+
+func (r *sometimesRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *sometimesRule) ApplyWithPresence(_ context.Context, value any, present bool, _ any) (any, ve.ValidationError) {
+	if !present {
+		r.MarkSkipped()
+	}
+
+	return value, nil
+}
+
+func (*sometimesRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleSometimes,
+	}
+}
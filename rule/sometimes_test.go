@@ -0,0 +1,56 @@
+package rule
+
+import (
+	"testing"
+)
+
+func Test_SometimesRule(t *testing.T) {
+	runRuleTestCases(t, sometimesRuleDataProvider)
+}
+
+func BenchmarkSometimesRule(b *testing.B) {
+	runRuleBenchmarks(b, sometimesRuleDataProvider)
+}
+
+func sometimesRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"missing": {
+			rule:             Sometimes(),
+			value:            nil,
+			missing:          true,
+			expectedNewValue: nil,
+			expectedError:    nil,
+			expectedToSkip:   true,
+		},
+
+		"nil": {
+			rule:             Sometimes(),
+			value:            nil,
+			expectedNewValue: nil,
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+		"pointer to string nil pointer": {
+			rule:             Sometimes(),
+			value:            (*string)(nil),
+			expectedNewValue: (*string)(nil),
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+
+		"int": {
+			rule:             Sometimes(),
+			value:            0,
+			expectedNewValue: 0,
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+		"string": {
+			rule:             Sometimes(),
+			value:            "",
+			expectedNewValue: "",
+			expectedError:    nil,
+			expectedToSkip:   false,
+		},
+	}
+}
@@ -43,6 +43,15 @@ func (r *startsWithRule) Apply(_ context.Context, value any, _ any) (any, ve.Val
 	return value, NewStartsWithValidationError(r.prefixes)
 }
 
+func (r *startsWithRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleStartsWith,
+		Params: map[string]any{
+			"prefixes": r.prefixes,
+		},
+	}
+}
+
 func NewStartsWithValidationError(prefixes []string) StartsWithValidationError {
 	return StartsWithValidationError{
 		BasicValidationError: ve.BasicValidationError{
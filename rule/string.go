@@ -29,6 +29,12 @@ func (r *stringRule) Apply(_ context.Context, value any, _ any) (any, ve.Validat
 	return value, nil
 }
 
+func (*stringRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleString,
+	}
+}
+
 func NewStringValidationError() StringValidationError {
 	return StringValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -30,6 +30,12 @@ func (r *structRule) Apply(_ context.Context, value any, _ any) (any, ve.Validat
 	return value, nil
 }
 
+func (*structRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleStruct,
+	}
+}
+
 func NewStructValidationError() StructValidationError {
 	return StructValidationError{
 		BasicValidationError: ve.BasicValidationError{
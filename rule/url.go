@@ -32,6 +32,12 @@ func (*urlRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationEr
 	return value, nil
 }
 
+func (*urlRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name: ve.RuleURL,
+	}
+}
+
 func NewUrlValidationError() UrlValidationError {
 	return UrlValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -3,6 +3,7 @@ package rule
 import (
 	"context"
 	"regexp"
+	"sort"
 
 	ve "github.com/donatorsky/go-validator/error"
 )
@@ -67,6 +68,31 @@ func (r *uuidRule) Apply(_ context.Context, value any, _ any) (any, ve.Validatio
 	return value, nil
 }
 
+func (r *uuidRule) Describe() RuleDescriptor {
+	var versions []int
+
+	for version, enabled := range map[int]bool{
+		1: r.options.version1,
+		3: r.options.version3,
+		4: r.options.version4,
+		5: r.options.version5,
+	} {
+		if enabled {
+			versions = append(versions, version)
+		}
+	}
+
+	sort.Ints(versions)
+
+	return RuleDescriptor{
+		Name: ve.RuleUUID,
+		Params: map[string]any{
+			"versions":  versions,
+			"allow_nil": r.options.allowNil,
+		},
+	}
+}
+
 func NewUuidValidationError() UuidValidationError {
 	return UuidValidationError{
 		BasicValidationError: ve.BasicValidationError{
@@ -0,0 +1,47 @@
+package rule
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Warn(rule Rule) *warnRule {
+	return &warnRule{
+		rule: rule,
+	}
+}
+
+type warnRule struct {
+	rule Rule
+}
+
+func (r *warnRule) Apply(ctx context.Context, value any, data any) (any, ve.ValidationError) {
+	return r.ApplyWithPresence(ctx, value, true, data)
+}
+
+func (r *warnRule) ApplyWithPresence(ctx context.Context, value any, present bool, data any) (any, ve.ValidationError) {
+	newValue, failures, notices := applyRules(ctx, value, present, data, []Rule{r.rule})
+
+	switch len(failures) {
+	case 0:
+		return newValue, notices
+
+	case 1:
+		return value, ve.WithSeverity(failures[0], ve.SeverityWarning)
+
+	default:
+		return value, ve.WithSeverity(ve.Join(failures...), ve.SeverityWarning)
+	}
+}
+
+func (r *warnRule) Combines() bool {
+	return true
+}
+
+func (r *warnRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:  ve.RuleWarn,
+		Rules: describeRules([]Rule{r.rule}),
+	}
+}
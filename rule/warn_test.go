@@ -0,0 +1,61 @@
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+func Test_WarnRule(t *testing.T) {
+	runRuleTestCases(t, warnRuleDataProvider)
+}
+
+func BenchmarkWarnRule(b *testing.B) {
+	runRuleBenchmarks(b, warnRuleDataProvider)
+}
+
+func warnRuleDataProvider() map[string]*ruleTestCaseData {
+	return map[string]*ruleTestCaseData{
+		"rule passes, value is modified": {
+			rule:             Warn(Default(5)),
+			value:            nil,
+			expectedNewValue: 5,
+			expectedError:    nil,
+		},
+		"rule fails": {
+			rule:             Warn(Max(100)),
+			value:            150,
+			expectedNewValue: 150,
+			expectedError:    ve.NewSeverityValidationError(NewMaxValidationError(ve.TypeNumber, 100, true), ve.SeverityWarning),
+		},
+		"rule fails and modifies value": {
+			rule:             Warn(Required()),
+			value:            nil,
+			missing:          true,
+			expectedNewValue: nil,
+			expectedError:    ve.NewSeverityValidationError(NewRequiredValidationError(), ve.SeverityWarning),
+		},
+		"rule returns multiple errors": {
+			rule: Warn(Custom(func(_ context.Context, value string, _ any) (string, error) {
+				return value, ve.Join(NewNumericValidationError(), NewFilledValidationError())
+			})),
+			value:            "",
+			expectedNewValue: "",
+			expectedErrorFunc: func(t *testing.T, err ve.ValidationError) bool {
+				return assert.Equal(t, ve.ValidationErrors{
+					ve.NewSeverityValidationError(NewNumericValidationError(), ve.SeverityWarning),
+					ve.NewSeverityValidationError(NewFilledValidationError(), ve.SeverityWarning),
+				}, err)
+			},
+		},
+		"rule with sub-rules": {
+			rule:             Warn(When(true, String(), Min(3))),
+			value:            "ab",
+			expectedNewValue: "ab",
+			expectedError:    ve.NewSeverityValidationError(NewMinValidationError(ve.TypeString, 3, true), ve.SeverityWarning),
+		},
+	}
+}
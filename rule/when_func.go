@@ -20,16 +20,31 @@ type whenFuncRule struct {
 
 	condition whenFuncCondition
 	rules     []Rule
+	elseRules []Rule
 }
 
 func (r *whenFuncRule) Apply(_ context.Context, _ any, _ any) (any, ve.ValidationError) {
 	return nil, nil
 }
 
+func (r *whenFuncRule) Else(rules ...Rule) *whenFuncRule {
+	r.elseRules = rules
+
+	return r
+}
+
 func (r *whenFuncRule) Rules(ctx context.Context, value any, data any) []Rule {
 	if !r.condition(ctx, value, data) {
-		return nil
+		return r.elseRules
 	}
 
 	return r.rules
 }
+
+func (r *whenFuncRule) Describe() RuleDescriptor {
+	return RuleDescriptor{
+		Name:  ve.RuleWhen,
+		Rules: describeRules(r.rules),
+		Else:  describeRules(r.elseRules),
+	}
+}
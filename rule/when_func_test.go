@@ -85,6 +85,26 @@ func Test_WhenFunc_Rules(t *testing.T) {
 				newRuleMock(1),
 			},
 		},
+
+		"condition is false, with else rules": {
+			rule: WhenFunc(func(_ context.Context, _ any, _ any) bool {
+				return false
+			}, newRuleMock(0)).Else(newRuleMock(1), newRuleMock(2)),
+			value: nil,
+			expectedRules: []Rule{
+				newRuleMock(1),
+				newRuleMock(2),
+			},
+		},
+		"condition is true, with else rules": {
+			rule: WhenFunc(func(_ context.Context, _ any, _ any) bool {
+				return true
+			}, newRuleMock(0)).Else(newRuleMock(1), newRuleMock(2)),
+			value: nil,
+			expectedRules: []Rule{
+				newRuleMock(0),
+			},
+		},
 	} {
 		t.Run(ttName, func(t *testing.T) {
 			// when
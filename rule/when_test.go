@@ -65,6 +65,22 @@ func Test_When_Rules(t *testing.T) {
 				newRuleMock(1),
 			},
 		},
+
+		"condition is false, with else rules": {
+			rule:  When(false, newRuleMock(0)).Else(newRuleMock(1), newRuleMock(2)),
+			value: nil,
+			expectedRules: []Rule{
+				newRuleMock(1),
+				newRuleMock(2),
+			},
+		},
+		"condition is true, with else rules": {
+			rule:  When(true, newRuleMock(0)).Else(newRuleMock(1), newRuleMock(2)),
+			value: nil,
+			expectedRules: []Rule{
+				newRuleMock(0),
+			},
+		},
 	} {
 		t.Run(ttName, func(t *testing.T) {
 			// when
@@ -0,0 +1,13 @@
+package sanitize
+
+import (
+	"strings"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func CollapseWhitespace() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleCollapseWhitespace}, func(value string) any {
+		return strings.Join(strings.Fields(value), " ")
+	})
+}
@@ -0,0 +1,28 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_CollapseWhitespace(t *testing.T) {
+	runSanitizerTestCases(t, collapseWhitespaceDataProvider)
+}
+
+func BenchmarkCollapseWhitespace(b *testing.B) {
+	runSanitizerBenchmarks(b, collapseWhitespaceDataProvider)
+}
+
+func collapseWhitespaceDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(CollapseWhitespace(), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             CollapseWhitespace(),
+			value:            " Foo \t\n Bar  Baz ",
+			expectedNewValue: "Foo Bar Baz",
+		},
+		"pointer to string": {
+			rule:             CollapseWhitespace(),
+			value:            ptr("Foo  Bar"),
+			expectedNewValue: "Foo Bar",
+		},
+	})
+}
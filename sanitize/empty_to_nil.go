@@ -0,0 +1,15 @@
+package sanitize
+
+import (
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func EmptyToNil() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleEmptyToNil}, func(value string) any {
+		if value == "" {
+			return nil
+		}
+
+		return value
+	})
+}
@@ -0,0 +1,33 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_EmptyToNil(t *testing.T) {
+	runSanitizerTestCases(t, emptyToNilDataProvider)
+}
+
+func BenchmarkEmptyToNil(b *testing.B) {
+	runSanitizerBenchmarks(b, emptyToNilDataProvider)
+}
+
+func emptyToNilDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(EmptyToNil(), map[string]*sanitizerTestCaseData{
+		"empty string": {
+			rule:             EmptyToNil(),
+			value:            "",
+			expectedNewValue: nil,
+		},
+		"pointer to empty string": {
+			rule:             EmptyToNil(),
+			value:            ptr(""),
+			expectedNewValue: nil,
+		},
+		"string": {
+			rule:             EmptyToNil(),
+			value:            " ",
+			expectedNewValue: " ",
+		},
+	})
+}
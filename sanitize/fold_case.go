@@ -0,0 +1,15 @@
+package sanitize
+
+import (
+	"golang.org/x/text/cases"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func FoldCase() *stringSanitizerRule {
+	caser := cases.Fold()
+
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleFoldCase}, func(value string) any {
+		return caser.String(value)
+	})
+}
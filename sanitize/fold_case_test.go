@@ -0,0 +1,28 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_FoldCase(t *testing.T) {
+	runSanitizerTestCases(t, foldCaseDataProvider)
+}
+
+func BenchmarkFoldCase(b *testing.B) {
+	runSanitizerBenchmarks(b, foldCaseDataProvider)
+}
+
+func foldCaseDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(FoldCase(), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             FoldCase(),
+			value:            "FoO Straße",
+			expectedNewValue: "foo strasse",
+		},
+		"pointer to string": {
+			rule:             FoldCase(),
+			value:            ptr("FOO"),
+			expectedNewValue: "foo",
+		},
+	})
+}
@@ -0,0 +1,84 @@
+package sanitize
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+var fakerInstance = faker.New()
+
+type sanitizerTestCaseData struct {
+	rule             vr.Rule
+	value            any
+	expectedNewValue any
+}
+
+type sanitizerTestCaseDataProvider func() map[string]*sanitizerTestCaseData
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func runSanitizerTestCases(t *testing.T, dataProvider sanitizerTestCaseDataProvider) {
+	// given
+	for ttName, tt := range dataProvider() {
+		t.Run(ttName, func(t *testing.T) {
+			// when
+			newValue, err := tt.rule.Apply(context.Background(), tt.value, nil)
+
+			// then
+			require.NoError(t, err, "Sanitizer is expected to not return error")
+			require.Equal(t, tt.expectedNewValue, newValue, "Sanitizer returned unexpected value")
+		})
+	}
+}
+
+func runSanitizerBenchmarks(b *testing.B, dataProvider sanitizerTestCaseDataProvider) {
+	for ttName, tt := range dataProvider() {
+		b.Run(ttName, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = tt.rule.Apply(context.Background(), tt.value, nil)
+			}
+		})
+	}
+}
+
+func commonSanitizerTestCases(rule vr.Rule) map[string]*sanitizerTestCaseData {
+	var intDummy = fakerInstance.Int()
+
+	return map[string]*sanitizerTestCaseData{
+		"nil": {
+			rule:             rule,
+			value:            nil,
+			expectedNewValue: nil,
+		},
+		"pointer to string nil pointer": {
+			rule:             rule,
+			value:            (*string)(nil),
+			expectedNewValue: (*string)(nil),
+		},
+		"int": {
+			rule:             rule,
+			value:            intDummy,
+			expectedNewValue: intDummy,
+		},
+		"slice of strings": {
+			rule:             rule,
+			value:            []string{" Foo "},
+			expectedNewValue: []string{" Foo "},
+		},
+	}
+}
+
+func withCommonSanitizerTestCases(rule vr.Rule, testCases map[string]*sanitizerTestCaseData) map[string]*sanitizerTestCaseData {
+	for name, testCase := range commonSanitizerTestCases(rule) {
+		testCases[name] = testCase
+	}
+
+	return testCases
+}
@@ -0,0 +1,13 @@
+package sanitize
+
+import (
+	"golang.org/x/text/unicode/norm"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func NormalizeNFC() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleNormalizeNFC}, func(value string) any {
+		return norm.NFC.String(value)
+	})
+}
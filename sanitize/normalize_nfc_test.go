@@ -0,0 +1,33 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_NormalizeNFC(t *testing.T) {
+	runSanitizerTestCases(t, normalizeNFCDataProvider)
+}
+
+func BenchmarkNormalizeNFC(b *testing.B) {
+	runSanitizerBenchmarks(b, normalizeNFCDataProvider)
+}
+
+func normalizeNFCDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(NormalizeNFC(), map[string]*sanitizerTestCaseData{
+		"decomposed string": {
+			rule:             NormalizeNFC(),
+			value:            "e\u0301",
+			expectedNewValue: "\u00e9",
+		},
+		"composed string": {
+			rule:             NormalizeNFC(),
+			value:            "\u00e9",
+			expectedNewValue: "\u00e9",
+		},
+		"pointer to string": {
+			rule:             NormalizeNFC(),
+			value:            ptr("e\u0301"),
+			expectedNewValue: "\u00e9",
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package sanitize
+
+import (
+	"context"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+const (
+	RuleCollapseWhitespace = "COLLAPSE_WHITESPACE"
+	RuleEmptyToNil         = "EMPTY_TO_NIL"
+	RuleFoldCase           = "FOLD_CASE"
+	RuleNormalizeNFC       = "NORMALIZE_NFC"
+	RuleStripTags          = "STRIP_TAGS"
+	RuleToLower            = "TO_LOWER"
+	RuleToUpper            = "TO_UPPER"
+	RuleTrim               = "TRIM"
+	RuleTrimSpace          = "TRIM_SPACE"
+)
+
+type stringSanitizer func(value string) any
+
+func newStringSanitizerRule(descriptor vr.RuleDescriptor, sanitizer stringSanitizer) *stringSanitizerRule {
+	return &stringSanitizerRule{
+		descriptor: descriptor,
+		sanitizer:  sanitizer,
+	}
+}
+
+type stringSanitizerRule struct {
+	descriptor vr.RuleDescriptor
+	sanitizer  stringSanitizer
+}
+
+func (r *stringSanitizerRule) Apply(_ context.Context, value any, _ any) (any, ve.ValidationError) {
+	v, isNil := vr.Dereference(value)
+	if isNil {
+		return value, nil
+	}
+
+	stringValue, ok := v.(string)
+	if !ok {
+		return value, nil
+	}
+
+	return r.sanitizer(stringValue), nil
+}
+
+func (r *stringSanitizerRule) Describe() vr.RuleDescriptor {
+	return r.descriptor
+}
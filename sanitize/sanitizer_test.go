@@ -0,0 +1,28 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_StringSanitizerRule_Describe(t *testing.T) {
+	for ttIdx, tt := range []struct {
+		rule     vr.DescribableRule
+		expected vr.RuleDescriptor
+	}{
+		{rule: CollapseWhitespace(), expected: vr.RuleDescriptor{Name: RuleCollapseWhitespace}},
+		{rule: EmptyToNil(), expected: vr.RuleDescriptor{Name: RuleEmptyToNil}},
+		{rule: FoldCase(), expected: vr.RuleDescriptor{Name: RuleFoldCase}},
+		{rule: NormalizeNFC(), expected: vr.RuleDescriptor{Name: RuleNormalizeNFC}},
+		{rule: StripTags(), expected: vr.RuleDescriptor{Name: RuleStripTags}},
+		{rule: ToLower(), expected: vr.RuleDescriptor{Name: RuleToLower}},
+		{rule: ToUpper(), expected: vr.RuleDescriptor{Name: RuleToUpper}},
+		{rule: Trim("-"), expected: vr.RuleDescriptor{Name: RuleTrim, Params: map[string]any{"cutset": "-"}}},
+		{rule: TrimSpace(), expected: vr.RuleDescriptor{Name: RuleTrimSpace}},
+	} {
+		require.Equal(t, tt.expected, tt.rule.Describe(), ttIdx)
+	}
+}
@@ -0,0 +1,15 @@
+package sanitize
+
+import (
+	"regexp"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+var tagsRegex = regexp.MustCompile(`<!--[\s\S]*?-->|<[^>]*>`)
+
+func StripTags() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleStripTags}, func(value string) any {
+		return tagsRegex.ReplaceAllString(value, "")
+	})
+}
@@ -0,0 +1,33 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_StripTags(t *testing.T) {
+	runSanitizerTestCases(t, stripTagsDataProvider)
+}
+
+func BenchmarkStripTags(b *testing.B) {
+	runSanitizerBenchmarks(b, stripTagsDataProvider)
+}
+
+func stripTagsDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(StripTags(), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             StripTags(),
+			value:            "<p class=\"foo\">Foo <b>Bar</b><br/></p><!-- <i>Baz</i> -->",
+			expectedNewValue: "Foo Bar",
+		},
+		"string without tags": {
+			rule:             StripTags(),
+			value:            "Foo &lt; Bar",
+			expectedNewValue: "Foo &lt; Bar",
+		},
+		"pointer to string": {
+			rule:             StripTags(),
+			value:            ptr("<i>Foo</i>"),
+			expectedNewValue: "Foo",
+		},
+	})
+}
@@ -0,0 +1,13 @@
+package sanitize
+
+import (
+	"strings"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func ToLower() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleToLower}, func(value string) any {
+		return strings.ToLower(value)
+	})
+}
@@ -0,0 +1,28 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_ToLower(t *testing.T) {
+	runSanitizerTestCases(t, toLowerDataProvider)
+}
+
+func BenchmarkToLower(b *testing.B) {
+	runSanitizerBenchmarks(b, toLowerDataProvider)
+}
+
+func toLowerDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(ToLower(), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             ToLower(),
+			value:            "FoO BÄR",
+			expectedNewValue: "foo bär",
+		},
+		"pointer to string": {
+			rule:             ToLower(),
+			value:            ptr("FOO"),
+			expectedNewValue: "foo",
+		},
+	})
+}
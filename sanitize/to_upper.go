@@ -0,0 +1,13 @@
+package sanitize
+
+import (
+	"strings"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func ToUpper() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleToUpper}, func(value string) any {
+		return strings.ToUpper(value)
+	})
+}
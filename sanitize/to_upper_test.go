@@ -0,0 +1,28 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_ToUpper(t *testing.T) {
+	runSanitizerTestCases(t, toUpperDataProvider)
+}
+
+func BenchmarkToUpper(b *testing.B) {
+	runSanitizerBenchmarks(b, toUpperDataProvider)
+}
+
+func toUpperDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(ToUpper(), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             ToUpper(),
+			value:            "fOo bär",
+			expectedNewValue: "FOO BÄR",
+		},
+		"pointer to string": {
+			rule:             ToUpper(),
+			value:            ptr("foo"),
+			expectedNewValue: "FOO",
+		},
+	})
+}
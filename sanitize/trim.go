@@ -0,0 +1,18 @@
+package sanitize
+
+import (
+	"strings"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Trim(cutset string) *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{
+		Name: RuleTrim,
+		Params: map[string]any{
+			"cutset": cutset,
+		},
+	}, func(value string) any {
+		return strings.Trim(value, cutset)
+	})
+}
@@ -0,0 +1,13 @@
+package sanitize
+
+import (
+	"strings"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func TrimSpace() *stringSanitizerRule {
+	return newStringSanitizerRule(vr.RuleDescriptor{Name: RuleTrimSpace}, func(value string) any {
+		return strings.TrimSpace(value)
+	})
+}
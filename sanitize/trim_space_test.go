@@ -0,0 +1,33 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_TrimSpace(t *testing.T) {
+	runSanitizerTestCases(t, trimSpaceDataProvider)
+}
+
+func BenchmarkTrimSpace(b *testing.B) {
+	runSanitizerBenchmarks(b, trimSpaceDataProvider)
+}
+
+func trimSpaceDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(TrimSpace(), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             TrimSpace(),
+			value:            " \t Foo Bar \n",
+			expectedNewValue: "Foo Bar",
+		},
+		"pointer to string": {
+			rule:             TrimSpace(),
+			value:            ptr(" Foo "),
+			expectedNewValue: "Foo",
+		},
+		"empty string": {
+			rule:             TrimSpace(),
+			value:            "",
+			expectedNewValue: "",
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package sanitize
+
+import (
+	"testing"
+)
+
+func Test_Trim(t *testing.T) {
+	runSanitizerTestCases(t, trimDataProvider)
+}
+
+func BenchmarkTrim(b *testing.B) {
+	runSanitizerBenchmarks(b, trimDataProvider)
+}
+
+func trimDataProvider() map[string]*sanitizerTestCaseData {
+	return withCommonSanitizerTestCases(Trim("-_"), map[string]*sanitizerTestCaseData{
+		"string": {
+			rule:             Trim("-_"),
+			value:            "-_Foo-Bar_-",
+			expectedNewValue: "Foo-Bar",
+		},
+		"pointer to string": {
+			rule:             Trim("-_"),
+			value:            ptr("_Foo_"),
+			expectedNewValue: "Foo",
+		},
+	})
+}
@@ -0,0 +1,104 @@
+//go:build go1.21
+
+package sloghooks
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	ve "github.com/donatorsky/go-validator/error"
+)
+
+type hooksOption func(hooks *Hooks)
+
+func New(logger *slog.Logger, options ...hooksOption) *Hooks {
+	hooks := &Hooks{
+		logger:       logger,
+		level:        slog.LevelDebug,
+		failureLevel: slog.LevelInfo,
+	}
+
+	for _, option := range options {
+		option(hooks)
+	}
+
+	return hooks
+}
+
+func WithLevel(level slog.Level) hooksOption {
+	return func(hooks *Hooks) {
+		hooks.level = level
+	}
+}
+
+func WithFailureLevel(level slog.Level) hooksOption {
+	return func(hooks *Hooks) {
+		hooks.failureLevel = level
+	}
+}
+
+type Hooks struct {
+	logger       *slog.Logger
+	level        slog.Level
+	failureLevel slog.Level
+}
+
+func (h *Hooks) OnFieldStart(ctx context.Context, field string) {
+	h.logger.LogAttrs(ctx, h.level, "validating field",
+		slog.String("field", field),
+	)
+}
+
+func (h *Hooks) OnRuleApplied(ctx context.Context, field string, rule string, duration time.Duration, err ve.ValidationError) {
+	if err == nil {
+		h.logger.LogAttrs(ctx, h.level, "rule passed",
+			slog.String("field", field),
+			slog.String("rule", rule),
+			slog.Duration("duration", duration),
+		)
+
+		return
+	}
+
+	if !isFailure(err) {
+		h.logger.LogAttrs(ctx, h.level, "rule reported",
+			slog.String("field", field),
+			slog.String("rule", rule),
+			slog.Duration("duration", duration),
+			slog.String("severity", ve.SeverityOf(err).String()),
+			slog.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	h.logger.LogAttrs(ctx, h.failureLevel, "rule failed",
+		slog.String("field", field),
+		slog.String("rule", rule),
+		slog.Duration("duration", duration),
+		slog.String("error", err.Error()),
+	)
+}
+
+func (h *Hooks) OnValidationDone(ctx context.Context, errorsBag ve.ErrorsBag, duration time.Duration) {
+	level := h.level
+	if errorsBag.Any() {
+		level = h.failureLevel
+	}
+
+	h.logger.LogAttrs(ctx, level, "validation done",
+		slog.Int("failed_fields", len(errorsBag.Errors())),
+		slog.Duration("duration", duration),
+	)
+}
+
+func isFailure(err ve.ValidationError) bool {
+	for _, validationError := range ve.Flatten(err) {
+		if ve.SeverityOf(validationError) == ve.SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+//go:build go1.21
+
+package sloghooks
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	validator "github.com/donatorsky/go-validator"
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+var _ validator.Hooks = (*Hooks)(nil)
+
+func newTestLogger(output *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey || attr.Key == "duration" {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}))
+}
+
+func Test_Hooks(t *testing.T) {
+	// given
+	var output bytes.Buffer
+
+	hooks := New(newTestLogger(&output))
+
+	// when
+	hooks.OnFieldStart(context.TODO(), "name")
+	hooks.OnRuleApplied(context.TODO(), "name", ve.RuleString, time.Millisecond, nil)
+	hooks.OnRuleApplied(context.TODO(), "name", ve.RuleRequired, time.Millisecond, vr.NewRequiredValidationError())
+	hooks.OnRuleApplied(context.TODO(), "name", ve.RuleMin, time.Millisecond, ve.WithSeverity(vr.NewMinValidationError(ve.TypeString, 3, true), ve.SeverityWarning))
+	hooks.OnValidationDone(context.TODO(), ve.ErrorsBag{"name": {vr.NewRequiredValidationError()}}, time.Millisecond)
+	hooks.OnValidationDone(context.TODO(), ve.NewErrorsBag(), time.Millisecond)
+
+	// then
+	require.Equal(t, `level=DEBUG msg="validating field" field=name
+level=DEBUG msg="rule passed" field=name rule=STRING
+level=INFO msg="rule failed" field=name rule=REQUIRED error="is required"
+level=DEBUG msg="rule reported" field=name rule=MIN severity=warning error="must be at least 3 characters"
+level=INFO msg="validation done" failed_fields=1
+level=DEBUG msg="validation done" failed_fields=0
+`, output.String())
+}
+
+func Test_Hooks_WithLevels(t *testing.T) {
+	// given
+	var output bytes.Buffer
+
+	hooks := New(newTestLogger(&output), WithLevel(slog.LevelInfo), WithFailureLevel(slog.LevelWarn))
+
+	// when
+	_, err := validator.ForMap(map[string]any{}, validator.RulesMap{
+		"name": {
+			vr.Required(),
+		},
+	}, validator.ForMapWithHooks(hooks))
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, `level=INFO msg="validating field" field=name
+level=WARN msg="rule failed" field=name rule=REQUIRED error="is required"
+level=WARN msg="validation done" failed_fields=1
+`, output.String())
+}
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type StructValidatorBuilder[T any] struct {
+	rules     RulesMap
+	selectors int
+	err       error
+}
+
+func For[T any]() *StructValidatorBuilder[T] {
+	return &StructValidatorBuilder[T]{
+		rules: RulesMap{},
+	}
+}
+
+func (b *StructValidatorBuilder[T]) Field(selector func(t *T) any, rules ...vr.Rule) *StructValidatorBuilder[T] {
+	b.selectors++
+
+	if b.err != nil {
+		return b
+	}
+
+	field, ok := resolveFieldPath(selector)
+	if !ok {
+		b.err = ve.UnreachableFieldError{
+			Type:     reflect.TypeOf((*T)(nil)).Elem().String(),
+			Selector: b.selectors,
+		}
+
+		return b
+	}
+
+	b.rules[field] = append(b.rules[field], rules...)
+
+	return b
+}
+
+func (b *StructValidatorBuilder[T]) Build() (*StructValidator[T], error) {
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() != reflect.Struct {
+		return nil, ve.NotStructTypeError{}
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	rules := make(RulesMap, len(b.rules))
+	for field, fieldRules := range b.rules {
+		rules[field] = append([]vr.Rule(nil), fieldRules...)
+	}
+
+	return &StructValidator[T]{
+		rules: rules,
+	}, nil
+}
+
+type StructValidator[T any] struct {
+	rules RulesMap
+}
+
+func (v *StructValidator[T]) Rules() RulesMap {
+	return v.rules
+}
+
+func (v *StructValidator[T]) Validate(data *T, options ...forStructValidatorOption) (ve.ErrorsBag, error) {
+	return v.ValidateWithContext(context.Background(), data, options...)
+}
+
+func (v *StructValidator[T]) ValidateWithContext(ctx context.Context, data *T, options ...forStructValidatorOption) (ve.ErrorsBag, error) {
+	return ForStructWithContext(ctx, data, v.rules, options...)
+}
+
+func resolveFieldPath[T any](selector func(t *T) any) (field string, ok bool) {
+	defer func() {
+		if recover() != nil {
+			field, ok = "", false
+		}
+	}()
+
+	root := new(T)
+
+	selected := reflect.ValueOf(selector(root))
+	if selected.Kind() != reflect.Pointer || selected.IsNil() {
+		return "", false
+	}
+
+	rootValue := reflect.ValueOf(root).Elem()
+	if rootValue.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	return findFieldPath(rootValue, selected.Pointer(), selected.Type().Elem())
+}
+
+func findFieldPath(structValue reflect.Value, address uintptr, fieldType reflect.Type) (string, bool) {
+	structType := structValue.Type()
+
+	for idx := 0; idx < structType.NumField(); idx++ {
+		field := structType.Field(idx)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		fieldValue := structValue.Field(idx)
+		fieldAddress := fieldValue.UnsafeAddr()
+
+		if fieldAddress == address && field.Type == fieldType && field.IsExported() {
+			return field.Name, true
+		}
+
+		if field.Type.Kind() != reflect.Struct || address < fieldAddress || address >= fieldAddress+field.Type.Size() {
+			continue
+		}
+
+		path, ok := findFieldPath(fieldValue, address, fieldType)
+		if !ok {
+			continue
+		}
+
+		if field.Anonymous {
+			return path, true
+		}
+
+		return field.Name + "." + path, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type builderAddress struct {
+	Street string
+	City   string
+}
+
+type builderEmbedded struct {
+	Nickname string
+}
+
+type builderRequest struct {
+	builderEmbedded
+
+	Email    string
+	Age      int
+	Address  builderAddress
+	Previous *builderAddress
+	Tags     []string
+	internal string
+}
+
+func Test_For_Build(t *testing.T) {
+	// given
+	var (
+		emailRules    = []vr.Rule{vr.Required(), vr.Email()}
+		ageRules      = []vr.Rule{vr.Min(18)}
+		addressRules  = []vr.Rule{vr.Required()}
+		streetRules   = []vr.Rule{vr.Filled()}
+		nicknameRules = []vr.Rule{vr.String()}
+		previousRules = []vr.Rule{vr.Nullable()}
+		tagsRules     = []vr.Rule{vr.Slice()}
+	)
+
+	// when
+	validator, err := For[builderRequest]().
+		Field(func(t *builderRequest) any { return &t.Email }, emailRules...).
+		Field(func(t *builderRequest) any { return &t.Age }, ageRules...).
+		Field(func(t *builderRequest) any { return &t.Address }, addressRules...).
+		Field(func(t *builderRequest) any { return &t.Address.Street }, streetRules...).
+		Field(func(t *builderRequest) any { return &t.Nickname }, nicknameRules...).
+		Field(func(t *builderRequest) any { return &t.Previous }, previousRules...).
+		Field(func(t *builderRequest) any { return &t.Tags }, tagsRules[0]).
+		Build()
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, RulesMap{
+		"Email":          emailRules,
+		"Age":            ageRules,
+		"Address":        addressRules,
+		"Address.Street": streetRules,
+		"Nickname":       nicknameRules,
+		"Previous":       previousRules,
+		"Tags":           tagsRules,
+	}, validator.Rules())
+}
+
+func Test_For_Build_MergesRulesOfTheSameField(t *testing.T) {
+	// when
+	validator, err := For[builderRequest]().
+		Field(func(t *builderRequest) any { return &t.Email }, vr.Required()).
+		Field(func(t *builderRequest) any { return &t.Email }, vr.Email()).
+		Build()
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, RulesMap{
+		"Email": {vr.Required(), vr.Email()},
+	}, validator.Rules())
+}
+
+func Test_For_Build_FailsWhenFieldIsUnreachable(t *testing.T) {
+	var (
+		outside string
+	)
+
+	for ttIdx, tt := range []struct {
+		name     string
+		selector func(t *builderRequest) any
+	}{
+		{
+			name:     "nil",
+			selector: func(t *builderRequest) any { return nil },
+		},
+		{
+			name:     "value instead of pointer",
+			selector: func(t *builderRequest) any { return t.Email },
+		},
+		{
+			name:     "pointer to the struct itself",
+			selector: func(t *builderRequest) any { return t },
+		},
+		{
+			name:     "pointer outside of the struct",
+			selector: func(t *builderRequest) any { return &outside },
+		},
+		{
+			name:     "unexported field",
+			selector: func(t *builderRequest) any { return &t.internal },
+		},
+		{
+			name:     "field behind nil pointer",
+			selector: func(t *builderRequest) any { return &t.Previous.Street },
+		},
+		{
+			name:     "slice element",
+			selector: func(t *builderRequest) any { return &t.Tags[0] },
+		},
+	} {
+		ttIdx, tt := ttIdx, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			validator, err := For[builderRequest]().
+				Field(func(t *builderRequest) any { return &t.Email }).
+				Field(tt.selector).
+				Field(func(t *builderRequest) any { return &t.Age }).
+				Build()
+
+			// then
+			require.Nil(t, validator, ttIdx)
+			require.ErrorIs(t, err, ve.UnreachableFieldError{
+				Type:     "validator.builderRequest",
+				Selector: 2,
+			}, ttIdx)
+		})
+	}
+}
+
+func Test_For_Build_FailsWhenTypeIsNotStruct(t *testing.T) {
+	// when
+	validator, err := For[map[string]any]().Build()
+
+	// then
+	require.Nil(t, validator)
+	require.ErrorIs(t, err, ve.NotStructTypeError{})
+}
+
+func Test_StructValidator_ValidateWithContext(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = &builderRequest{
+			Email: "invalid",
+			Age:   21,
+			Address: builderAddress{
+				Street: "",
+				City:   "Foo City",
+			},
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	validator, err := For[builderRequest]().
+		Field(func(t *builderRequest) any { return &t.Email }, vr.Required(), vr.Email()).
+		Field(func(t *builderRequest) any { return &t.Age }, vr.Min(18)).
+		Field(func(t *builderRequest) any { return &t.Address.Street }, vr.Filled()).
+		Build()
+	require.NoError(t, err)
+
+	// when
+	errorsBag, err := validator.ValidateWithContext(ctx, data, ForStructWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 2)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewEmailValidationError()}, "Email"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewFilledValidationError()}, "Address.Street"))
+
+	require.True(t, assertCollectorHasValue(t, collector, "Age", 21))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "Email"))
+}
@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type Trace struct {
+	Fields []*FieldTrace
+}
+
+type FieldTrace struct {
+	Field          string
+	Missing        bool
+	InactiveGroups bool
+	Rules          []RuleTrace
+}
+
+type RuleTrace struct {
+	Rule     string
+	Input    any
+	Output   any
+	Error    ve.ValidationError
+	Expanded bool
+	SubRules []string
+	Bailed   bool
+	Skipped  bool
+}
+
+func (t *Trace) Field(field string) []*FieldTrace {
+	var fields []*FieldTrace
+
+	for _, fieldTrace := range t.Fields {
+		if fieldTrace.Field == field {
+			fields = append(fields, fieldTrace)
+		}
+	}
+
+	return fields
+}
+
+func (t *Trace) String() string {
+	var builder strings.Builder
+
+	for _, fieldTrace := range t.Fields {
+		builder.WriteString(fieldTrace.String())
+	}
+
+	return builder.String()
+}
+
+func (t *FieldTrace) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(t.Field)
+
+	if t.Missing {
+		builder.WriteString(" (missing)")
+	}
+
+	if t.InactiveGroups {
+		builder.WriteString(" (skipped: no active group)")
+	}
+
+	builder.WriteString(":\n")
+
+	for _, ruleTrace := range t.Rules {
+		builder.WriteString("  ")
+		builder.WriteString(ruleTrace.String())
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+func (t RuleTrace) String() string {
+	if t.Expanded {
+		if len(t.SubRules) == 0 {
+			return fmt.Sprintf("%s: no rules to apply", t.Rule)
+		}
+
+		return fmt.Sprintf("%s: expanded into %s", t.Rule, strings.Join(t.SubRules, ", "))
+	}
+
+	message := fmt.Sprintf("%s: %#v -> %#v", t.Rule, t.Input, t.Output)
+
+	if t.Error != nil {
+		message += fmt.Sprintf(", error: %s", t.Error)
+	}
+
+	if t.Bailed {
+		message += ", bailed"
+	}
+
+	if t.Skipped {
+		message += ", skipped remaining rules"
+	}
+
+	return message
+}
+
+func (t *Trace) startField(fieldValue fieldValue) *FieldTrace {
+	fieldTrace := &FieldTrace{
+		Field:   fieldValue.field,
+		Missing: fieldValue.missing,
+	}
+
+	t.Fields = append(t.Fields, fieldTrace)
+
+	return fieldTrace
+}
+
+func (t *FieldTrace) ruleApplied(rule vr.Rule, input, output any, err ve.ValidationError) *RuleTrace {
+	t.Rules = append(t.Rules, RuleTrace{
+		Rule:   vr.Describe(rule).Name,
+		Input:  input,
+		Output: output,
+		Error:  err,
+	})
+
+	return &t.Rules[len(t.Rules)-1]
+}
+
+func (t *FieldTrace) rulesExpanded(rule vr.Rule, subRules []vr.Rule, value any) {
+	names := make([]string, len(subRules))
+	for idx, subRule := range subRules {
+		names[idx] = vr.Describe(subRule).Name
+	}
+
+	t.Rules = append(t.Rules, RuleTrace{
+		Rule:     vr.Describe(rule).Name,
+		Input:    value,
+		Output:   value,
+		Expanded: true,
+		SubRules: names,
+	})
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+func Test_Trace_String(t *testing.T) {
+	// given
+	trace := Trace{
+		Fields: []*FieldTrace{
+			{
+				Field: "name",
+				Rules: []RuleTrace{
+					{Rule: "TRIM_SPACE", Input: " Foo ", Output: "Foo"},
+					{Rule: ve.RuleWhen, Input: "Foo", Output: "Foo", Expanded: true, SubRules: []string{}},
+					{Rule: ve.RuleWhen, Input: "Foo", Output: "Foo", Expanded: true, SubRules: []string{ve.RuleRequired, ve.RuleMin}},
+				},
+			},
+			{
+				Field:   "age",
+				Missing: true,
+				Rules: []RuleTrace{
+					{Rule: ve.RuleRequired, Error: vr.NewRequiredValidationError(), Bailed: true},
+				},
+			},
+			{
+				Field:   "email",
+				Missing: true,
+				Rules: []RuleTrace{
+					{Rule: ve.RuleSometimes, Skipped: true},
+				},
+			},
+			{
+				Field:          "role",
+				InactiveGroups: true,
+			},
+		},
+	}
+
+	// when
+	output := trace.String()
+
+	// then
+	require.Equal(t, `name:
+  TRIM_SPACE: " Foo " -> "Foo"
+  WHEN: no rules to apply
+  WHEN: expanded into REQUIRED, MIN
+age (missing):
+  REQUIRED: <nil> -> <nil>, error: is required, bailed
+email (missing):
+  SOMETIMES: <nil> -> <nil>, skipped remaining rules
+role (skipped: no active group):
+`, output)
+}
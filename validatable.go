@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+
+	ve "github.com/donatorsky/go-validator/error"
+	"github.com/donatorsky/go-validator/internal/engine"
+)
+
+type Validatable interface {
+	ValidationRules() RulesMap
+}
+
+type SelfValidator interface {
+	Validate(ctx context.Context) ve.ErrorsBag
+}
+
+func applySelfValidation(ctx context.Context, value any, parentField string, errorsBag ve.ErrorsBag, options *validatorOptions) (anyRuleFailed bool, _ error) {
+	value = withPointerReceiver(value)
+
+	if validatable, ok := value.(Validatable); ok {
+		nestedErrorsBag, err := applyNestedRules(ctx, value, engine.Targets(validatable.ValidationRules(), value), parentField, options)
+		if err != nil {
+			return false, err
+		}
+
+		for field, errors := range nestedErrorsBag {
+			errorsBag.Add(field, errors...)
+		}
+
+		anyRuleFailed = nestedErrorsBag.Any()
+	}
+
+	if selfValidator, ok := value.(SelfValidator); ok {
+		for field, errors := range selfValidator.Validate(ctx) {
+			errorsBag.Add(joinFields(parentField, field), errors...)
+
+			for _, err := range errors {
+				if ve.SeverityOf(err) == ve.SeverityError {
+					anyRuleFailed = true
+				}
+			}
+		}
+	}
+
+	return anyRuleFailed, nil
+}
+
+var (
+	validatableType   = reflect.TypeOf((*Validatable)(nil)).Elem()
+	selfValidatorType = reflect.TypeOf((*SelfValidator)(nil)).Elem()
+)
+
+func withPointerReceiver(value any) any {
+	if value == nil {
+		return nil
+	}
+
+	valueOf := reflect.ValueOf(value)
+	if valueOf.Kind() == reflect.Pointer {
+		return value
+	}
+
+	pointerType := reflect.PointerTo(valueOf.Type())
+	if !pointerType.Implements(validatableType) && !pointerType.Implements(selfValidatorType) {
+		return value
+	}
+
+	pointer := reflect.New(valueOf.Type())
+	pointer.Elem().Set(valueOf)
+
+	return pointer.Interface()
+}
+
+func joinFields(parentField, field string) string {
+	if parentField == "" {
+		return field
+	}
+
+	return parentField + "." + field
+}
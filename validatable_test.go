@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ve "github.com/donatorsky/go-validator/error"
+	vr "github.com/donatorsky/go-validator/rule"
+)
+
+type validatableAddress struct {
+	Street string
+	City   string
+}
+
+func (validatableAddress) ValidationRules() RulesMap {
+	return RulesMap{
+		"Street": {
+			vr.Filled(),
+		},
+		"City": {
+			vr.Filled(),
+		},
+	}
+}
+
+type selfValidatingMoney struct {
+	Amount   int
+	Currency string
+}
+
+func (m *selfValidatingMoney) Validate(_ context.Context) ve.ErrorsBag {
+	errorsBag := ve.NewErrorsBag()
+
+	if m.Amount < 0 {
+		errorsBag.Add("Amount", vr.NewMinValidationError(ve.TypeNumber, 0, true))
+	}
+
+	if m.Currency == "" {
+		errorsBag.Add("Currency", vr.NewFilledValidationError())
+	}
+
+	return errorsBag
+}
+
+type validatableOrder struct {
+	Addresses []validatableAddress
+	Total     *selfValidatingMoney
+}
+
+func (validatableOrder) ValidationRules() RulesMap {
+	return RulesMap{
+		"Total": {
+			vr.Required(),
+		},
+	}
+}
+
+func Test_ForStructWithContext_WithValidatable(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = &validatableOrder{
+			Addresses: []validatableAddress{
+				{Street: "Foo Street", City: "Foo City"},
+				{Street: "", City: "Bar City"},
+			},
+			Total: &selfValidatingMoney{
+				Amount:   -1,
+				Currency: "EUR",
+			},
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForStructWithContext(ctx, data, RulesMap{
+		"Addresses.*": {
+			vr.Required(),
+		},
+	}, ForStructWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, errorsBag, 2)
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewFilledValidationError()}, "Addresses.1.Street"))
+	require.True(t, assertErrorsBagContainsErrorsForField(t, errorsBag, []ve.ValidationError{vr.NewMinValidationError(ve.TypeNumber, 0, true)}, "Total.Amount"))
+
+	require.True(t, assertCollectorHasValue(t, collector, "Addresses.0", data.Addresses[0]))
+	require.True(t, assertCollectorHasValue(t, collector, "Addresses.0.Street", "Foo Street"))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "Addresses.1"))
+	require.True(t, assertCollectorDoesNotHaveKey(t, collector, "Total"))
+}
+
+func Test_ForValueWithContext_WithSelfValidator(t *testing.T) {
+	// given
+	var ctx = context.TODO()
+
+	// when
+	validationErrors, err := ForValueWithContext(ctx, &selfValidatingMoney{Amount: 1}, []vr.Rule{
+		vr.Required(),
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []ve.ValidationError{
+		ve.NewFieldValidationError("Currency", vr.NewFilledValidationError()),
+	}, validationErrors)
+}
+
+type selfValidatingNote struct {
+	Text string
+}
+
+func (n *selfValidatingNote) Validate(_ context.Context) ve.ErrorsBag {
+	errorsBag := ve.NewErrorsBag()
+
+	errorsBag.Add("Author")
+
+	if len(n.Text) > 3 {
+		errorsBag.Add("Text", ve.WithSeverity(vr.NewMaxValidationError(ve.TypeString, 3, true), ve.SeverityWarning))
+	}
+
+	return errorsBag
+}
+
+type selfValidatingTicket struct {
+	Note *selfValidatingNote
+}
+
+func Test_ForStructWithContext_WithSelfValidatorReturningWarnings(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = &selfValidatingTicket{
+			Note: &selfValidatingNote{Text: "Lorem"},
+		}
+	)
+
+	collector := NewMapDataCollector()
+
+	// when
+	errorsBag, err := ForStructWithContext(ctx, data, RulesMap{
+		"Note": {
+			vr.Required(),
+		},
+	}, ForStructWithDataCollector(collector))
+
+	// then
+	require.NoError(t, err)
+	require.False(t, errorsBag.Any())
+	require.Len(t, errorsBag.Warnings(), 1)
+	require.True(t, assertCollectorHasValue(t, collector, "Note", *data.Note))
+}
+
+func Test_ForValueWithContext_WithSelfValidatorInsideCombinators(t *testing.T) {
+	// given
+	var (
+		ctx  = context.TODO()
+		data = &selfValidatingTicket{
+			Note: &selfValidatingNote{Text: "Lorem"},
+		}
+	)
+
+	// when
+	validationErrors, err := ForValueWithContext(ctx, data, []vr.Rule{
+		vr.AnyOf([]vr.Rule{
+			vr.Nested(RulesMap{
+				"Note": {
+					vr.Required(),
+				},
+			}),
+		}),
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []ve.ValidationError{
+		ve.NewFieldValidationError("Note.Text", ve.WithSeverity(vr.NewMaxValidationError(ve.TypeString, 3, true), ve.SeverityWarning)),
+	}, validationErrors)
+}
@@ -1,8 +1,61 @@
 package validator
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+	"time"
+
+	ve "github.com/donatorsky/go-validator/error"
+
+	vr "github.com/donatorsky/go-validator/rule"
+)
 
 type validatorOptions struct {
 	dataCollector DataCollector
 	valueExporter *reflect.Value
+	partial       bool
+	groups        []string
+	trace         *Trace
+	hooks         Hooks
+	before        []beforeHook
+	after         []afterHook
+	objectRules   []ObjectRule
+}
+
+type beforeHook func(ctx context.Context, data any) (any, error)
+
+type afterHook func(ctx context.Context, data any, errorsBag ve.ErrorsBag)
+
+func (o *validatorOptions) inActiveGroups(groups [][]string) bool {
+	for _, fieldGroups := range groups {
+		if !vr.IsAnyGroupActive(o.groups, fieldGroups) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (o *validatorOptions) validationDone(ctx context.Context, errorsBag ve.ErrorsBag, startedAt time.Time) {
+	if o.hooks != nil {
+		o.hooks.OnValidationDone(ctx, errorsBag, time.Since(startedAt))
+	}
+}
+
+func (o *validatorOptions) applyBefore(ctx context.Context, data any) (any, error) {
+	for _, hook := range o.before {
+		var err error
+
+		if data, err = hook(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+func (o *validatorOptions) applyAfter(ctx context.Context, data any, errorsBag ve.ErrorsBag) {
+	for _, hook := range o.after {
+		hook(ctx, data, errorsBag)
+	}
 }